@@ -0,0 +1,420 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/mcpserver"
+	"github.com/tenkoh/recent-go-mcp/internal/service"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+// Output formats supported by the CLI
+const (
+	formatMarkdown = "markdown"
+	formatJSON     = "json"
+)
+
+// Exit codes returned by runCLI
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const cliUsage = `Usage:
+  recent-go-mcp [server flags]       Run as an MCP stdio server
+  recent-go-mcp query [flags]        Show the Go features available in a version
+  recent-go-mcp versions [flags]     List the supported Go versions with change counts
+  recent-go-mcp search <term> [flags] Find features whose package, API or description mentions term
+
+Server flags (override the config file and the RECENT_GO_MCP_* environment variables):
+  -config path                       YAML config file (default $RECENT_GO_MCP_CONFIG)
+  -log-level debug|info|warn|error   Minimum level of log records (default info)
+  -log-format json|text              Log record format (default json)
+  -log-file path                     Append logs to a file instead of stderr
+
+Every command also accepts -config path, which replaces $RECENT_GO_MCP_CONFIG.
+Run 'recent-go-mcp <command> -h' for the flags of a command.
+`
+
+// runCLI runs a CLI subcommand and returns the process exit code
+func runCLI(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, cliUsage)
+		return exitUsage
+	}
+
+	var run func(context.Context, *mcpserver.Server, []string, io.Writer, io.Writer) int
+	switch args[0] {
+	case "query":
+		run = runQuery
+	case "versions":
+		run = runVersions
+	case "search":
+		run = runSearch
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, cliUsage)
+		return exitOK
+	default:
+		fmt.Fprintf(stderr, "unknown command %q\n\n%s", args[0], cliUsage)
+		return exitUsage
+	}
+
+	configPath, commandArgs, err := extractConfigFlag(args[1:])
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", args[0], err)
+		return exitUsage
+	}
+	getenv := func(key string) string {
+		if key == config.FileEnv && configPath != "" {
+			return configPath
+		}
+		return os.Getenv(key)
+	}
+	cfg, err := config.FromEnv(getenv)
+	if err != nil {
+		fmt.Fprintf(stderr, "invalid configuration: %v\n", err)
+		return exitUsage
+	}
+
+	m, err := mcpserver.New(cfg)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load release data: %v\n", err)
+		return exitError
+	}
+
+	return run(ctx, m, commandArgs, stdout, stderr)
+}
+
+// extractConfigFlag removes the -config flag shared by every command from args and returns its value
+// It is read before the command's own flags, whose defaults come from the server built from the config
+func extractConfigFlag(args []string) (path string, rest []string, err error) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return path, append(rest, args[i:]...), nil
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !strings.HasPrefix(arg, "-") || name != "config" {
+			rest = append(rest, arg)
+			continue
+		}
+		if !hasValue {
+			if i+1 == len(args) {
+				return "", nil, errors.New("flag needs an argument: -config")
+			}
+			i++
+			value = args[i]
+		}
+		path = value
+	}
+	return path, rest, nil
+}
+
+// runQuery prints the features available in a version, like the go-updates tool
+func runQuery(ctx context.Context, m *mcpserver.Server, args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("query", stderr)
+	version := flags.String("version", "", "Go version your project is using (required, e.g., 1.22)")
+	packageName := flags.String("package", "", "only show changes to this standard library package (e.g., net/http)")
+	includeUpcoming := flags.Bool("include-upcoming", false, "also list features of releases after the version")
+	includeExperimental := flags.Bool("include-experimental", false, "include experimental features")
+	format := flags.String("format", formatMarkdown, "output format: "+strings.Join(m.Formats().Names(), ", "))
+	locale := localeFlag(flags, m)
+	detail := flags.String("detail", m.Config().DetailLevel, "detail of the output: "+strings.Join(domain.DetailLevels(), ", "))
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	// Normalize like the tool arguments, e.g. "go1.22" -> "1.22"
+	*version = service.NormalizeVersionArg(*version)
+	*packageName = service.NormalizePackageArg(*packageName)
+	if *version == "" {
+		fmt.Fprintln(stderr, "query: -version is required")
+		return exitUsage
+	}
+	if code := validateFormat(*format, m.Formats().Names(), *locale, stderr); code != exitOK {
+		return code
+	}
+	if !domain.IsSupportedDetailLevel(*detail) {
+		fmt.Fprintf(stderr, "unsupported detail level %q (use %s)\n", *detail, strings.Join(domain.DetailLevels(), ", "))
+		return exitUsage
+	}
+
+	response, err := m.FeatureService().QueryFeatures(ctx, domain.FeatureQuery{
+		Version:             *version,
+		Package:             *packageName,
+		IncludeUpcoming:     *includeUpcoming,
+		IncludeExperimental: *includeExperimental,
+	})
+	if err != nil {
+		return reportError(stderr, "query", err)
+	}
+
+	return writeFeatures(stdout, stderr, m, *format, response, m.FormatOptions(*version, *packageName, *locale, *detail))
+}
+
+// runVersions lists the supported versions, newest first, like the go-versions tool
+func runVersions(ctx context.Context, m *mcpserver.Server, args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("versions", stderr)
+	format := flags.String("format", "text", "output format: text, markdown or json")
+	includeExperimental := flags.Bool("include-experimental", false, "count experimental features")
+	locale := localeFlag(flags, m)
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *format != "text" {
+		if code := validateFormat(*format, []string{formatMarkdown, formatJSON}, *locale, stderr); code != exitOK {
+			return code
+		}
+	}
+
+	catalog, err := m.VersionService().ListVersions(ctx, *includeExperimental)
+	if err != nil {
+		return reportError(stderr, "versions", err)
+	}
+
+	switch *format {
+	case formatJSON:
+		return writeJSON(stdout, stderr, catalog)
+	case formatMarkdown:
+		fmt.Fprint(stdout, m.Formatter(*locale, "").FormatVersionCatalog(catalog))
+	default:
+		// One tab-separated line per release for shell scripts
+		for _, release := range catalog.Releases {
+			released := ""
+			if !release.ReleaseDate.IsZero() {
+				released = release.ReleaseDate.Format("2006-01-02")
+			}
+			fmt.Fprintf(stdout, "%s\t%s\t%s\n", release.Version, released, release.Summary)
+		}
+	}
+	return exitOK
+}
+
+// runSearch prints the features whose package, API name or description contains a term
+func runSearch(ctx context.Context, m *mcpserver.Server, args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("search", stderr)
+	version := flags.String("version", "", "search features available up to this Go version (default: latest)")
+	includeExperimental := flags.Bool("include-experimental", false, "include experimental features")
+	format := flags.String("format", formatMarkdown, "output format: "+strings.Join(m.Formats().Names(), ", "))
+	locale := localeFlag(flags, m)
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	// Allow flags after the term, e.g. "search iter -format json"
+	if flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "search: a search term is required")
+		return exitUsage
+	}
+	term := flags.Arg(0)
+	if err := flags.Parse(flags.Args()[1:]); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "search: unexpected arguments %q\n", flags.Args())
+		return exitUsage
+	}
+	if code := validateFormat(*format, m.Formats().Names(), *locale, stderr); code != exitOK {
+		return code
+	}
+
+	*version = service.NormalizeVersionArg(*version)
+	if *version == "" {
+		latest, err := m.Repository().GetLatestVersion(ctx)
+		if err != nil {
+			return reportError(stderr, "search", err)
+		}
+		*version = latest
+	}
+
+	response, err := m.FeatureService().QueryFeatures(ctx, domain.FeatureQuery{
+		Version:             *version,
+		IncludeExperimental: *includeExperimental,
+	})
+	if err != nil {
+		return reportError(stderr, "search", err)
+	}
+
+	return writeFeatures(stdout, stderr, m, *format, searchFeatures(response, term), m.FormatOptions(*version, "", *locale, ""))
+}
+
+// searchFeatures returns a copy of response reduced to the entries mentioning term, ignoring case
+// The term must start a word, so "iter" finds iterators but not literals
+// The response may be shared with the cache, so it is never modified
+func searchFeatures(response *domain.FeatureResponse, term string) *domain.FeatureResponse {
+	pattern := regexp.MustCompile(`(?i)(^|[^\pL\pN_])` + regexp.QuoteMeta(term))
+	contains := func(fields ...string) bool {
+		return slices.ContainsFunc(fields, pattern.MatchString)
+	}
+	matchChange := func(change domain.Change) bool {
+		return contains(change.Category, change.Description)
+	}
+	matchPackageChange := func(packageName string, change domain.PackageChange) bool {
+		return contains(packageName, change.Function, change.Type, change.Description)
+	}
+
+	matches := &domain.FeatureResponse{
+		FromVersion:     response.FromVersion,
+		ToVersion:       response.ToVersion,
+		PackageInfo:     make(map[string][]domain.PackageChange),
+		VersionChanges:  make(map[string][]domain.Change),
+		VersionPackages: make(map[string]map[string][]domain.PackageChange),
+	}
+
+	// Walk versions oldest first so the flat Changes and PackageInfo lists stay chronological
+	versions := slices.SortedFunc(maps.Keys(response.VersionChanges), version.NewSemanticVersionComparator().Compare)
+
+	for _, v := range versions {
+		changes := response.VersionChanges[v]
+		var matched []domain.Change
+		for _, change := range changes {
+			if matchChange(change) {
+				matched = append(matched, change)
+			}
+		}
+		// Every version keeps its key; the formatter lists versions from VersionChanges
+		matches.VersionChanges[v] = matched
+		matches.Changes = append(matches.Changes, matched...)
+	}
+
+	for _, v := range versions {
+		packages := response.VersionPackages[v]
+		for _, packageName := range slices.Sorted(maps.Keys(packages)) {
+			changes := packages[packageName]
+			var matched []domain.PackageChange
+			for _, change := range changes {
+				if matchPackageChange(packageName, change) {
+					matched = append(matched, change)
+				}
+			}
+			if len(matched) == 0 {
+				continue
+			}
+			if matches.VersionPackages[v] == nil {
+				matches.VersionPackages[v] = make(map[string][]domain.PackageChange)
+			}
+			matches.VersionPackages[v][packageName] = matched
+			matches.PackageInfo[packageName] = append(matches.PackageInfo[packageName], matched...)
+		}
+	}
+
+	matches.SearchTerm = term
+	matches.Summary = service.FeatureSummary(matches, "")
+
+	return matches
+}
+
+// isHelpArg reports whether arg asks for the usage text, which runCLI prints
+func isHelpArg(arg string) bool {
+	return slices.Contains([]string{"help", "-h", "-help", "--help"}, arg)
+}
+
+// parseServerFlags resolves the server settings: the config file, then environment variables, then flags
+// Errors are reported on stderr
+func parseServerFlags(args []string, getenv func(string) string, stderr io.Writer) (config.Config, error) {
+	flags := newFlagSet("recent-go-mcp", stderr)
+	flags.Usage = func() { fmt.Fprint(stderr, cliUsage) }
+	configPath := flags.String("config", getenv(config.FileEnv), "YAML config file")
+	level := flags.String("log-level", "", "minimum level of log records: debug, info, warn or error")
+	format := flags.String("log-format", "", "log record format: json or text")
+	file := flags.String("log-file", "", "append logs to this file instead of stderr")
+	if err := flags.Parse(args); err != nil {
+		return config.Config{}, err
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "unexpected arguments %q\n\n%s", flags.Args(), cliUsage)
+		return config.Config{}, fmt.Errorf("unexpected arguments %q", flags.Args())
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err == nil {
+		cfg, err = cfg.WithEnv(getenv)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return cfg, err
+	}
+
+	if *level != "" {
+		cfg.Logging.Level = *level
+	}
+	if *format != "" {
+		cfg.Logging.Format = *format
+	}
+	if *file != "" {
+		cfg.Logging.File = *file
+	}
+
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(stderr, "invalid configuration: %v\n", err)
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// newFlagSet creates a flag set that reports errors instead of exiting
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	return flags
+}
+
+// localeFlag defines the -locale flag shared by the subcommands, defaulting to the configured locale
+func localeFlag(flags *flag.FlagSet, m *mcpserver.Server) *string {
+	return flags.String("locale", m.Config().Locale, "language of the output: "+strings.Join(service.Locales(), ", ")+"; untranslated entries fall back to English")
+}
+
+// validateFormat checks the shared -format and -locale flags against the formats a command supports
+func validateFormat(format string, formats []string, locale string, stderr io.Writer) int {
+	if !slices.Contains(formats, format) {
+		fmt.Fprintf(stderr, "unsupported format %q (use %s)\n", format, strings.Join(formats, ", "))
+		return exitUsage
+	}
+	if !service.IsSupportedLocale(locale) {
+		fmt.Fprintf(stderr, "unsupported locale %q (use %s)\n", locale, strings.Join(service.Locales(), ", "))
+		return exitUsage
+	}
+	return exitOK
+}
+
+// reportError prints a service error with the same wording as tool error results and returns the exit code
+func reportError(stderr io.Writer, command string, err error) int {
+	appErr := mcpserver.ClassifyError(err)
+	fmt.Fprintf(stderr, "%s: %s: %s\n", command, appErr.Type.UserMessage(), appErr.Message)
+	return exitError
+}
+
+// writeFeatures writes a feature listing in one of the registered formats
+func writeFeatures(stdout, stderr io.Writer, m *mcpserver.Server, format string, response *domain.FeatureResponse, opts domain.FormatOptions) int {
+	text, err := m.Formats().Format(format, response, opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to format the output: %v\n", err)
+		return exitError
+	}
+	fmt.Fprintln(stdout, text)
+	return exitOK
+}
+
+// writeJSON writes v as indented JSON
+func writeJSON(stdout, stderr io.Writer, v any) int {
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		fmt.Fprintf(stderr, "failed to encode JSON: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
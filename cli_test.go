@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/logging"
+)
+
+func TestRunCLI(t *testing.T) {
+	ctx := context.Background()
+
+	run := func(args ...string) (int, string, string) {
+		var stdout, stderr bytes.Buffer
+		code := runCLI(ctx, args, &stdout, &stderr)
+		return code, stdout.String(), stderr.String()
+	}
+
+	t.Run("query markdown", func(t *testing.T) {
+		code, stdout, stderr := run("query", "-version", "1.22", "-package", "net/http")
+		if code != exitOK {
+			t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+		}
+		if !strings.HasPrefix(stdout, "# Go Features Available (Go 1.22)") {
+			t.Errorf("Expected markdown output, got:\n%s", stdout)
+		}
+		if !strings.Contains(stdout, "Revolutionary routing with method-specific patterns") {
+			t.Errorf("Expected net/http changes, got:\n%s", stdout)
+		}
+	})
+
+	t.Run("query json", func(t *testing.T) {
+		code, stdout, stderr := run("query", "--version", "1.22", "--package", "net/http", "--format", "json")
+		if code != exitOK {
+			t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+		}
+
+		var response domain.FeatureResponse
+		if err := json.Unmarshal([]byte(stdout), &response); err != nil {
+			t.Fatalf("Expected JSON output: %v", err)
+		}
+		if response.ToVersion != "1.22" || len(response.PackageInfo["net/http"]) == 0 {
+			t.Errorf("Unexpected response: to %s with %d net/http changes", response.ToVersion, len(response.PackageInfo["net/http"]))
+		}
+	})
+
+	t.Run("query llm", func(t *testing.T) {
+		code, stdout, stderr := run("query", "-version", "1.22", "-package", "net/http", "-format", "llm")
+		if code != exitOK {
+			t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+		}
+		if !strings.Contains(stdout, "\n1.22 net/http.") || strings.Contains(stdout, "##") {
+			t.Errorf("Expected one line per change without headings, got:\n%s", stdout)
+		}
+	})
+
+	t.Run("query errors", func(t *testing.T) {
+		if code, _, _ := run("query"); code != exitUsage {
+			t.Errorf("Expected usage error without -version, got %d", code)
+		}
+		if code, _, _ := run("query", "-version", "1.22", "-format", "yaml"); code != exitUsage {
+			t.Errorf("Expected usage error for unknown format, got %d", code)
+		}
+		if code, _, stderr := run("query", "-version", "1.99"); code != exitError || !strings.Contains(stderr, "not found") {
+			t.Errorf("Expected not found error, got %d: %s", code, stderr)
+		}
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		code, stdout, stderr := run("versions", "-format", "json")
+		if code != exitOK {
+			t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+		}
+
+		var catalog domain.VersionCatalog
+		if err := json.Unmarshal([]byte(stdout), &catalog); err != nil {
+			t.Fatalf("Expected JSON output: %v", err)
+		}
+		if len(catalog.Releases) == 0 || catalog.Releases[len(catalog.Releases)-1].Version != "1.13" {
+			t.Errorf("Expected releases newest first ending with 1.13, got %+v", catalog.Releases)
+		}
+
+		code, stdout, _ = run("versions")
+		if code != exitOK || !strings.HasPrefix(stdout, catalog.LatestVersion+"\t") {
+			t.Errorf("Expected tab-separated lines starting with the latest version, got %d:\n%s", code, stdout)
+		}
+	})
+
+	t.Run("search", func(t *testing.T) {
+		// Flags may follow the term
+		code, stdout, stderr := run("search", "iter", "-version", "1.23")
+		if code != exitOK {
+			t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+		}
+		if !strings.Contains(stdout, "#### Package `iter`") {
+			t.Errorf("Expected iter package section, got:\n%s", stdout)
+		}
+		// Matches start a word, so "literals" does not match "iter"
+		if strings.Contains(stdout, "literals") {
+			t.Errorf("Expected no mid-word matches, got:\n%s", stdout)
+		}
+
+		// Localized output keeps the search summary
+		code, stdout, stderr = run("search", "iter", "-version", "1.23", "-locale", "ja")
+		if code != exitOK || !strings.Contains(stdout, "'iter' を含む変更が") {
+			t.Errorf("Expected the Japanese search summary, got %d: %s\n%s", code, stderr, stdout)
+		}
+
+		if code, _, _ := run("search"); code != exitUsage {
+			t.Errorf("Expected usage error without a term, got %d", code)
+		}
+	})
+
+	t.Run("config flag", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(configPath, []byte("locale: ja\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		// -config may appear anywhere among the flags of a command
+		code, stdout, stderr := run("versions", "-format", "markdown", "-config", configPath)
+		if code != exitOK || !strings.HasPrefix(stdout, "# サポートされている Go のバージョン") {
+			t.Errorf("Expected the configured locale, got %d: %s\n%s", code, stderr, stdout)
+		}
+
+		if code, _, stderr := run("query", "-config"); code != exitUsage || !strings.Contains(stderr, "-config") {
+			t.Errorf("Expected a usage error without a path, got %d: %s", code, stderr)
+		}
+		if code, _, stderr := run("versions", "--config="+filepath.Join(t.TempDir(), "missing.yaml")); code != exitUsage || !strings.Contains(stderr, "invalid configuration") {
+			t.Errorf("Expected a missing config file to be reported, got %d: %s", code, stderr)
+		}
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		if code, _, stderr := run("serve-http"); code != exitUsage || !strings.Contains(stderr, "Usage:") {
+			t.Errorf("Expected usage error, got %d: %s", code, stderr)
+		}
+	})
+}
+
+func TestSearchFeatures(t *testing.T) {
+	response := &domain.FeatureResponse{
+		FromVersion: "1.21",
+		ToVersion:   "1.23",
+		VersionChanges: map[string][]domain.Change{
+			"1.21": {{Category: "language", Description: "Binary literals", Impact: "new"}},
+			"1.23": {{Category: "language", Description: "Range over iterator functions", Impact: "new"}},
+		},
+		VersionPackages: map[string]map[string][]domain.PackageChange{
+			"1.21": {"slices": {{Function: "Sort", Description: "Sort a slice", Impact: "new"}}},
+			"1.23": {
+				"iter":   {{Type: "Seq", Description: "Sequence type", Impact: "new"}},
+				"slices": {{Function: "All", Description: "Returns an iterator", Impact: "new"}},
+			},
+		},
+	}
+
+	matches := searchFeatures(response, "ITER")
+
+	if matches.Summary != "Found 3 changes mentioning 'ITER' in Go 1.21 through 1.23" {
+		t.Errorf("Unexpected summary: %s", matches.Summary)
+	}
+
+	if len(matches.Changes) != 1 || matches.Changes[0].Description != "Range over iterator functions" {
+		t.Errorf("Expected only the iterator language change, got %+v", matches.Changes)
+	}
+	if len(matches.PackageInfo["iter"]) != 1 || len(matches.PackageInfo["slices"]) != 1 {
+		t.Errorf("Expected iter.Seq and slices.All, got %+v", matches.PackageInfo)
+	}
+	if _, exists := matches.VersionChanges["1.21"]; !exists {
+		t.Error("Expected every version to be kept for the formatter")
+	}
+	// The input may be cached and must not be modified
+	if len(response.VersionPackages["1.21"]["slices"]) != 1 {
+		t.Error("Expected the original response to be unchanged")
+	}
+}
+
+func TestParseServerFlags(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte("locale: ja\nlogging:\n  level: error\n  format: text\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]string{
+		config.FileEnv:     configPath,
+		logging.LevelEnv:   "warn",
+		config.LocaleEnv:   "",
+		config.HTTPAddrEnv: ":9090",
+	}
+	getenv := func(key string) string { return env[key] }
+
+	var stderr bytes.Buffer
+	cfg, err := parseServerFlags([]string{"-log-level", "debug", "-log-file", "server.log"}, getenv, &stderr)
+	if err != nil {
+		t.Fatalf("parseServerFlags failed: %v (%s)", err, stderr.String())
+	}
+
+	// Flags win over the environment, which wins over the config file
+	want := logging.Options{Level: "debug", Format: "text", File: "server.log"}
+	if cfg.Logging != want {
+		t.Errorf("logging = %+v, want %+v", cfg.Logging, want)
+	}
+	if cfg.Locale != "ja" || cfg.Transport.Mode != config.TransportHTTP || cfg.Transport.HTTPAddr != ":9090" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	if _, err := parseServerFlags([]string{"-log-level", "debug", "extra"}, getenv, &stderr); err == nil {
+		t.Error("expected an error for positional arguments")
+	}
+
+	stderr.Reset()
+	if _, err := parseServerFlags([]string{"-log-format", "xml"}, getenv, &stderr); err == nil || !strings.Contains(stderr.String(), "invalid configuration") {
+		t.Errorf("expected an invalid configuration error, got %v (%s)", err, stderr.String())
+	}
+}
@@ -0,0 +1,10 @@
+// Package data embeds the Go release data files shipped with the server
+package data
+
+import "embed"
+
+// FS holds the release data: one JSON file per release under releases/, translation overlays under locales/<locale>/
+// and the themes of the cheatsheet format in cheatsheet.json
+//
+//go:embed releases/*.json locales/*/*.json cheatsheet.json
+var FS embed.FS
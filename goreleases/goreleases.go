@@ -0,0 +1,305 @@
+// Package goreleases embeds the Go release data and MCP tools of recent-go-mcp in other Go programs
+//
+// A Client answers read-only queries over the release data: releases, versions, packages,
+// the features available in a version and side-by-side version comparisons. Feature listings
+// are rendered by a registry of named formats that embedders can extend, and RegisterTools
+// adds the tools to an existing MCP server.
+package goreleases
+
+import (
+	"context"
+	"io/fs"
+	"log/slog"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/mcpserver"
+	"github.com/tenkoh/recent-go-mcp/internal/service"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+// Version of the release data and tools
+const Version = mcpserver.Version
+
+// Release data and query results; values returned by a Client are shared and must not be modified
+type (
+	Release           = domain.GoRelease
+	Change            = domain.Change
+	PackageChange     = domain.PackageChange
+	FeatureQuery      = domain.FeatureQuery
+	FeatureResponse   = domain.FeatureResponse
+	VersionCatalog    = domain.VersionCatalog
+	VersionComparison = domain.VersionComparison
+)
+
+// Formatter renders query results as Markdown
+type Formatter = domain.ResponseFormatter
+
+// FeatureFormatter renders a FeatureResponse in one output format
+type FeatureFormatter = domain.FeatureFormatter
+
+// FormatOptions controls how a FeatureFormatter renders a response
+type FormatOptions = domain.FormatOptions
+
+// Built-in formats of feature listings
+const (
+	FormatMarkdown   = service.FormatMarkdown
+	FormatJSON       = service.FormatJSON
+	FormatText       = service.FormatText
+	FormatHTML       = service.FormatHTML
+	FormatLLM        = service.FormatLLM
+	FormatCheatsheet = service.FormatCheatsheet
+)
+
+// Config holds the settings of a Client; they mirror the keys of the server's config file
+type Config struct {
+	// ReleasesDir and LocalesDir point at release data on disk that replaces the embedded copy
+	ReleasesDir string
+	LocalesDir  string
+	// CheatsheetFile replaces the themes of the cheatsheet format
+	CheatsheetFile string
+	// DetailLevel is the detail of feature listings when a call does not choose one
+	DetailLevel string
+	// Locale is the language of responses when a call does not choose one
+	Locale string
+	// EnabledTools limits the tools added by RegisterTools; empty adds all of them
+	EnabledTools []string
+	// PackageAllowlist limits the values of the package argument of the tools; Client queries are not restricted
+	PackageAllowlist []string
+	// CacheSize bounds the number of cached responses and formatted outputs
+	CacheSize int
+	// VerifiedOnly hides entries not checked against the upstream documentation
+	VerifiedOnly bool
+}
+
+func fromServerConfig(cfg config.Config) Config {
+	return Config{
+		ReleasesDir:      cfg.Data.ReleasesDir,
+		LocalesDir:       cfg.Data.LocalesDir,
+		CheatsheetFile:   cfg.Data.CheatsheetFile,
+		DetailLevel:      cfg.DetailLevel,
+		Locale:           cfg.Locale,
+		EnabledTools:     slices.Clone(cfg.EnabledTools),
+		PackageAllowlist: slices.Clone(cfg.PackageAllowlist),
+		CacheSize:        cfg.CacheSize,
+		VerifiedOnly:     cfg.VerifiedOnly,
+	}
+}
+
+// serverConfig converts the settings on top of the server defaults, which keep the transport and logging settings
+func (c Config) serverConfig() config.Config {
+	cfg := config.Default()
+	cfg.Data = config.Data{
+		ReleasesDir:    c.ReleasesDir,
+		LocalesDir:     c.LocalesDir,
+		CheatsheetFile: c.CheatsheetFile,
+	}
+	cfg.DetailLevel = c.DetailLevel
+	cfg.Locale = c.Locale
+	cfg.EnabledTools = slices.Clone(c.EnabledTools)
+	cfg.PackageAllowlist = slices.Clone(c.PackageAllowlist)
+	cfg.CacheSize = c.CacheSize
+	cfg.VerifiedOnly = c.VerifiedOnly
+	return cfg
+}
+
+// Error is returned by failed queries; use errors.As to inspect its Type
+type Error = domain.ApplicationError
+
+// ErrorType classifies an Error
+type ErrorType = domain.ErrType
+
+// Error types of failed queries
+const (
+	ErrNotFound     = domain.ErrTypeNotFound
+	ErrVersion      = domain.ErrTypeVersion
+	ErrValidation   = domain.ErrTypeValidation
+	ErrInvalidInput = domain.ErrTypeInvalidInput
+	ErrRepository   = domain.ErrTypeRepository
+	ErrService      = domain.ErrTypeService
+)
+
+// DefaultConfig returns the settings the server uses without a config file
+func DefaultConfig() Config {
+	return fromServerConfig(config.Default())
+}
+
+// LoadConfig reads a YAML config file in the format of the server's -config flag
+// The transport and logging settings of the file do not apply to a Client
+func LoadConfig(path string) (Config, error) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		return Config{}, err
+	}
+	return fromServerConfig(cfg), nil
+}
+
+// Option configures a Client
+type Option func(*options)
+
+type options struct {
+	config    Config
+	logger    *slog.Logger
+	releaseFS fs.FS
+}
+
+// WithConfig replaces DefaultConfig(); start from it and change the settings that matter
+func WithConfig(cfg Config) Option {
+	return func(o *options) {
+		o.config = cfg
+	}
+}
+
+// WithLogger sets the logger of the client and its tools; slog.Default() is used otherwise
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithReleaseFS replaces the embedded release data
+// The filesystem must use the layout of the data directory: releases/go1.22.json and locales/ja/go1.22.json
+func WithReleaseFS(releaseFS fs.FS) Option {
+	return func(o *options) {
+		o.releaseFS = releaseFS
+	}
+}
+
+// Client queries the Go release data; it is safe for concurrent use
+type Client struct {
+	server *mcpserver.Server
+}
+
+// New loads the release data and creates a Client
+func New(opts ...Option) (*Client, error) {
+	options := options{
+		config: DefaultConfig(),
+		logger: slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	serverOpts := []mcpserver.Option{mcpserver.WithLogger(options.logger)}
+	if options.releaseFS != nil {
+		serverOpts = append(serverOpts, mcpserver.WithReleaseFS(readFS{options.releaseFS}))
+	}
+
+	s, err := mcpserver.New(options.config.serverConfig(), serverOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{server: s}, nil
+}
+
+// Releases returns every release, newest first
+func (c *Client) Releases(ctx context.Context) ([]*Release, error) {
+	return c.server.Repository().GetAllReleases(ctx)
+}
+
+// Release returns the release of a version (e.g., "1.22" or "go1.22")
+func (c *Client) Release(ctx context.Context, version string) (*Release, error) {
+	return c.server.Repository().GetReleaseByVersion(ctx, service.NormalizeVersionArg(version))
+}
+
+// Versions lists every release with its date, summary and change counts
+// Experimental changes are counted only when includeExperimental is set, like in FeatureQuery
+func (c *Client) Versions(ctx context.Context, includeExperimental bool) (*VersionCatalog, error) {
+	return c.server.VersionService().ListVersions(ctx, includeExperimental)
+}
+
+// LatestVersion returns the newest version with release data
+func (c *Client) LatestVersion(ctx context.Context) (string, error) {
+	return c.server.Repository().GetLatestVersion(ctx)
+}
+
+// OldestVersion returns the oldest version with release data
+func (c *Client) OldestVersion(ctx context.Context) (string, error) {
+	return c.server.Repository().GetOldestVersion(ctx)
+}
+
+// Packages returns the sorted import paths changed in any release up to version
+func (c *Client) Packages(ctx context.Context, version string) ([]string, error) {
+	return c.server.Repository().GetPackagesUpToVersion(ctx, service.NormalizeVersionArg(version))
+}
+
+// PackageVersions returns the versions that changed a package, oldest first
+func (c *Client) PackageVersions(ctx context.Context, packageName string) ([]string, error) {
+	return c.server.Repository().GetPackageVersions(ctx, service.NormalizePackageArg(packageName))
+}
+
+// Features returns the features available in a version, like the go-updates tool
+func (c *Client) Features(ctx context.Context, query FeatureQuery) (*FeatureResponse, error) {
+	query.Version = service.NormalizeVersionArg(query.Version)
+	query.Package = service.NormalizePackageArg(query.Package)
+	return c.server.FeatureService().QueryFeatures(ctx, query)
+}
+
+// CompareVersions compares the features of two versions, like the go-compare-versions tool
+// The versions may be given in any order; experimental changes are counted only when includeExperimental is set
+func (c *Client) CompareVersions(ctx context.Context, version, otherVersion string, includeExperimental bool) (*VersionComparison, error) {
+	return c.server.ComparisonService().CompareVersions(ctx,
+		service.NormalizeVersionArg(version), service.NormalizeVersionArg(otherVersion), includeExperimental)
+}
+
+// Formatter returns the Markdown formatter for a locale ("en", "ja") and detail level ("full", "brief")
+// Empty or unsupported values fall back to the configured defaults
+func (c *Client) Formatter(locale, detail string) Formatter {
+	return c.server.Formatter(locale, detail)
+}
+
+// FormatOptions returns the options for a listing of version, filled with the configured locale and detail level
+// Full listings include code examples
+func (c *Client) FormatOptions(version, packageName string) FormatOptions {
+	return c.server.FormatOptions(service.NormalizeVersionArg(version), service.NormalizePackageArg(packageName), "", "")
+}
+
+// Format renders a feature listing in a registered format (e.g., FormatMarkdown or FormatLLM)
+func (c *Client) Format(format string, response *FeatureResponse, opts FormatOptions) (string, error) {
+	return c.server.Formats().Format(format, response, opts)
+}
+
+// Formats returns the registered format names, sorted
+func (c *Client) Formats() []string {
+	return c.server.Formats().Names()
+}
+
+// RegisterFormat adds a format or replaces a built-in one
+// Registered formats are also accepted by the format argument of the go-updates tool
+func (c *Client) RegisterFormat(name string, formatter FeatureFormatter) {
+	c.server.RegisterFormat(name, formatter)
+}
+
+// RegisterTools adds the enabled recent-go-mcp tools to an existing MCP server
+// The server needs tool capabilities (server.WithToolCapabilities)
+func (c *Client) RegisterTools(s *server.MCPServer) error {
+	return c.server.Register(s)
+}
+
+// NewMCPServer creates a standalone MCP server named recent-go-mcp with the enabled tools registered
+func (c *Client) NewMCPServer() (*server.MCPServer, error) {
+	return c.server.NewMCPServer()
+}
+
+// Compare compares two Go versions with or without the "go" prefix (e.g., "1.21", "go1.22.3")
+// Returns: 1 if v1 > v2, -1 if v1 < v2, 0 if equal
+func Compare(v1, v2 string) int {
+	return version.NewSemanticVersionComparator().Compare(service.NormalizeVersionArg(v1), service.NormalizeVersionArg(v2))
+}
+
+// readFS adapts any fs.FS to the ReadDir and ReadFile methods the repository uses
+type readFS struct {
+	fs.FS
+}
+
+// ReadDir implements fs.ReadDirFS
+func (f readFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return fs.ReadDir(f.FS, name)
+}
+
+// ReadFile implements fs.ReadFileFS
+func (f readFS) ReadFile(name string) ([]byte, error) {
+	return fs.ReadFile(f.FS, name)
+}
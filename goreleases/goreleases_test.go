@@ -0,0 +1,324 @@
+package goreleases
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func newTestClient(t *testing.T, opts ...Option) *Client {
+	t.Helper()
+	c, err := New(opts...)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+func TestClient_Queries(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	t.Run("releases and versions", func(t *testing.T) {
+		releases, err := c.Releases(ctx)
+		if err != nil {
+			t.Fatalf("Releases failed: %v", err)
+		}
+		latest, err := c.LatestVersion(ctx)
+		if err != nil {
+			t.Fatalf("LatestVersion failed: %v", err)
+		}
+		oldest, err := c.OldestVersion(ctx)
+		if err != nil {
+			t.Fatalf("OldestVersion failed: %v", err)
+		}
+		if releases[0].Version != latest || releases[len(releases)-1].Version != oldest {
+			t.Errorf("expected releases from %s down to %s, got %s down to %s",
+				latest, oldest, releases[0].Version, releases[len(releases)-1].Version)
+		}
+
+		catalog, err := c.Versions(ctx, false)
+		if err != nil {
+			t.Fatalf("Versions failed: %v", err)
+		}
+		if len(catalog.Releases) != len(releases) {
+			t.Errorf("expected %d releases in the catalog, got %d", len(releases), len(catalog.Releases))
+		}
+
+		// The returned slice is a copy, so reordering it must not affect later calls
+		slices.Reverse(releases)
+		again, _ := c.Releases(ctx)
+		if again[0].Version != latest {
+			t.Errorf("expected the client's order to be unaffected, got %s first", again[0].Version)
+		}
+	})
+
+	t.Run("release by version", func(t *testing.T) {
+		release, err := c.Release(ctx, "go1.22")
+		if err != nil {
+			t.Fatalf("Release failed: %v", err)
+		}
+		if release.Version != "1.22" {
+			t.Errorf("expected release 1.22, got %s", release.Version)
+		}
+
+		var appErr *Error
+		if _, err := c.Release(ctx, "1.5"); !errors.As(err, &appErr) || appErr.Type != ErrNotFound {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("packages", func(t *testing.T) {
+		packages, err := c.Packages(ctx, "1.22")
+		if err != nil {
+			t.Fatalf("Packages failed: %v", err)
+		}
+		if !slices.Contains(packages, "slices") || !slices.IsSorted(packages) {
+			t.Errorf("expected sorted packages including slices, got %v", packages)
+		}
+
+		versions, err := c.PackageVersions(ctx, "log/slog/")
+		if err != nil {
+			t.Fatalf("PackageVersions failed: %v", err)
+		}
+		if len(versions) == 0 || versions[0] != "1.21" {
+			t.Errorf("expected log/slog to appear in 1.21 first, got %v", versions)
+		}
+	})
+
+	t.Run("features and formatting", func(t *testing.T) {
+		response, err := c.Features(ctx, FeatureQuery{Version: "go1.22", Package: "net/http"})
+		if err != nil {
+			t.Fatalf("Features failed: %v", err)
+		}
+		if len(response.PackageInfo["net/http"]) == 0 {
+			t.Fatal("expected net/http changes")
+		}
+
+		text := c.Formatter("", "").FormatAsText(response, "1.22", "net/http")
+		if !strings.Contains(text, "## Summary") || !strings.Contains(text, "net/http") {
+			t.Errorf("expected a Markdown response about net/http, got:\n%s", text)
+		}
+		if text := c.Formatter("ja", "brief").FormatAsText(response, "1.22", "net/http"); !strings.Contains(text, "## 概要") {
+			t.Errorf("expected a Japanese response, got:\n%s", text)
+		}
+	})
+
+	t.Run("compare versions", func(t *testing.T) {
+		comparison, err := c.CompareVersions(ctx, "1.22", "go1.21", false)
+		if err != nil {
+			t.Fatalf("CompareVersions failed: %v", err)
+		}
+		if comparison.FromVersion != "1.21" || comparison.ToVersion != "1.22" {
+			t.Errorf("expected 1.21 -> 1.22, got %s -> %s", comparison.FromVersion, comparison.ToVersion)
+		}
+	})
+}
+
+// upperFormatter is a custom format registered by an embedder
+type upperFormatter struct{}
+
+func (upperFormatter) Format(response *FeatureResponse, opts FormatOptions) (string, error) {
+	return strings.ToUpper(response.Summary), nil
+}
+
+func TestClient_Formats(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	response, err := c.Features(ctx, FeatureQuery{Version: "1.22", Package: "net/http"})
+	if err != nil {
+		t.Fatalf("Features failed: %v", err)
+	}
+
+	opts := c.FormatOptions("go1.22", "net/http")
+	if opts.Version != "1.22" || opts.Locale != "en" || opts.DetailLevel != "full" || !opts.IncludeExamples {
+		t.Errorf("unexpected default options: %+v", opts)
+	}
+
+	text, err := c.Format(FormatLLM, response, opts)
+	if err != nil || !strings.Contains(text, "1.22 net/http.") {
+		t.Errorf("expected the llm format, got %q (%v)", text, err)
+	}
+
+	text, err = c.Format(FormatCheatsheet, response, opts)
+	if err != nil || !strings.Contains(text, "## HTTP") {
+		t.Errorf("expected the cheatsheet format, got %q (%v)", text, err)
+	}
+
+	c.RegisterFormat("upper", upperFormatter{})
+	if !slices.Contains(c.Formats(), "upper") {
+		t.Fatalf("expected the registered format, got %v", c.Formats())
+	}
+	if text, err := c.Format("upper", response, opts); err != nil || text != strings.ToUpper(response.Summary) {
+		t.Errorf("expected the custom format, got %q (%v)", text, err)
+	}
+
+	var appErr *Error
+	if _, err := c.Format("yaml", response, opts); !errors.As(err, &appErr) || appErr.Type != ErrValidation {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		v1, v2 string
+		want   int
+	}{
+		{"1.21", "1.22", -1},
+		{"go1.22", "1.22", 0},
+		{"1.22.3", "go1.22", 1},
+		{"1.9", "1.10", -1},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.v1, tt.v2); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.v1, tt.v2, got, tt.want)
+		}
+	}
+}
+
+func TestNew_Options(t *testing.T) {
+	t.Run("release data", func(t *testing.T) {
+		c := newTestClient(t, WithReleaseFS(fstest.MapFS{
+			"releases/go1.30.json": &fstest.MapFile{Data: []byte(`{"version": "1.30", "summary": "Fixture release", "changes": [], "packages": {}}`)},
+		}))
+		latest, err := c.LatestVersion(context.Background())
+		if err != nil || latest != "1.30" {
+			t.Errorf("expected the fixture release, got %q (%v)", latest, err)
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.CacheSize = -1
+		if _, err := New(WithConfig(cfg)); err == nil {
+			t.Error("expected a validation error")
+		}
+	})
+
+	t.Run("config file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		data := "locale: ja\nenabled_tools: [go-versions]\ntransport:\n  mode: http\n"
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			t.Fatalf("LoadConfig failed: %v", err)
+		}
+		if cfg.Locale != "ja" || !slices.Equal(cfg.EnabledTools, []string{"go-versions"}) || cfg.CacheSize != DefaultConfig().CacheSize {
+			t.Errorf("unexpected config: %+v", cfg)
+		}
+		newTestClient(t, WithConfig(cfg))
+	})
+}
+
+func TestClient_RegisterTools(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.EnabledTools = []string{"go-updates", "go-versions"}
+	c := newTestClient(t, WithConfig(cfg))
+
+	// An embedding server with its own tools
+	s := server.NewMCPServer("host", "1.0.0", server.WithToolCapabilities(false))
+	s.AddTool(mcp.NewTool("host-tool"), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+	if err := c.RegisterTools(s); err != nil {
+		t.Fatalf("RegisterTools failed: %v", err)
+	}
+
+	cli, err := client.NewInProcessClient(s)
+	if err != nil {
+		t.Fatalf("Failed to create in-process client: %v", err)
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := cli.Start(ctx); err != nil {
+		t.Fatalf("Failed to start client: %v", err)
+	}
+	if _, err := cli.Initialize(ctx, mcp.InitializeRequest{
+		Params: mcp.InitializeParams{
+			ProtocolVersion: "2024-11-05",
+			ClientInfo:      mcp.Implementation{Name: "test-client", Version: "0.1.0"},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	tools, err := cli.ListTools(ctx, mcp.ListToolsRequest{})
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+	var names []string
+	for _, tool := range tools.Tools {
+		names = append(names, tool.Name)
+	}
+	slices.Sort(names)
+	if !slices.Equal(names, []string{"go-updates", "go-versions", "host-tool"}) {
+		t.Errorf("expected the host tool next to the enabled tools, got %v", names)
+	}
+
+	result, err := cli.CallTool(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "go-versions"}})
+	if err != nil {
+		t.Fatalf("Failed to call go-versions: %v", err)
+	}
+	if result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "1.22") {
+		t.Errorf("expected the version list, got %+v", result.Content)
+	}
+}
+
+func TestClient_RegisterFormatReplacesCachedListings(t *testing.T) {
+	c := newTestClient(t)
+	s, err := c.NewMCPServer()
+	if err != nil {
+		t.Fatalf("NewMCPServer failed: %v", err)
+	}
+	cli, err := client.NewInProcessClient(s)
+	if err != nil {
+		t.Fatalf("Failed to create in-process client: %v", err)
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := cli.Start(ctx); err != nil {
+		t.Fatalf("Failed to start client: %v", err)
+	}
+	if _, err := cli.Initialize(ctx, mcp.InitializeRequest{
+		Params: mcp.InitializeParams{
+			ProtocolVersion: "2024-11-05",
+			ClientInfo:      mcp.Implementation{Name: "test-client", Version: "0.1.0"},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	listing := func() string {
+		t.Helper()
+		result, err := cli.CallTool(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "go-updates", Arguments: map[string]any{"version": "1.22"}}})
+		if err != nil || result.IsError {
+			t.Fatalf("Failed to call go-updates: %v %+v", err, result)
+		}
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	if text := listing(); !strings.HasPrefix(text, "# Go Features Available") {
+		t.Fatalf("expected the Markdown listing, got %q", text)
+	}
+	c.RegisterFormat(FormatMarkdown, upperFormatter{})
+	if text := listing(); strings.HasPrefix(text, "# Go Features Available") || text != strings.ToUpper(text) {
+		t.Errorf("expected the replaced format instead of the cached listing, got %q", text)
+	}
+}
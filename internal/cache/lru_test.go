@@ -0,0 +1,66 @@
+package cache
+
+import "testing"
+
+func TestLRU(t *testing.T) {
+	t.Run("get and add", func(t *testing.T) {
+		c := NewLRU[string, int](2)
+
+		if _, ok := c.Get("a"); ok {
+			t.Fatal("expected miss on empty cache")
+		}
+
+		c.Add("a", 1)
+		if v, ok := c.Get("a"); !ok || v != 1 {
+			t.Errorf("Get(a) = %d, %v, want 1, true", v, ok)
+		}
+
+		stats := c.Stats()
+		if stats.Hits != 1 || stats.Misses != 1 {
+			t.Errorf("expected 1 hit and 1 miss, got %+v", stats)
+		}
+		if stats.HitRate() != 0.5 {
+			t.Errorf("expected hit rate 0.5, got %f", stats.HitRate())
+		}
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		c := NewLRU[string, int](2)
+		c.Add("a", 1)
+		c.Add("b", 2)
+		c.Get("a") // "b" becomes least recently used
+		c.Add("c", 3)
+
+		if _, ok := c.Get("b"); ok {
+			t.Error("expected b to be evicted")
+		}
+		if _, ok := c.Get("a"); !ok {
+			t.Error("expected a to remain cached")
+		}
+		if c.Len() != 2 {
+			t.Errorf("expected 2 entries, got %d", c.Len())
+		}
+		if c.Stats().Evictions != 1 {
+			t.Errorf("expected 1 eviction, got %d", c.Stats().Evictions)
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		c := NewLRU[string, int](2)
+		c.Add("a", 1)
+		c.Purge()
+
+		if c.Len() != 0 {
+			t.Errorf("expected empty cache after purge, got %d entries", c.Len())
+		}
+	})
+
+	t.Run("zero capacity disables caching", func(t *testing.T) {
+		c := NewLRU[string, int](0)
+		c.Add("a", 1)
+
+		if _, ok := c.Get("a"); ok {
+			t.Error("expected miss with zero capacity")
+		}
+	})
+}
@@ -0,0 +1,200 @@
+// Package config loads the server settings from a YAML file with environment variable overrides
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/logging"
+	"gopkg.in/yaml.v3"
+)
+
+// Transports
+const (
+	TransportStdio = "stdio"
+	TransportHTTP  = "http"
+)
+
+// Environment variables overriding the config file; logging is overridden by the logging package's variables
+const (
+	FileEnv             = "RECENT_GO_MCP_CONFIG"
+	TransportEnv        = "RECENT_GO_MCP_TRANSPORT"
+	HTTPAddrEnv         = "RECENT_GO_MCP_HTTP_ADDR"
+	ReleasesDirEnv      = "RECENT_GO_MCP_RELEASES_DIR"
+	LocalesDirEnv       = "RECENT_GO_MCP_LOCALES_DIR"
+	CheatsheetFileEnv   = "RECENT_GO_MCP_CHEATSHEET_FILE"
+	DetailLevelEnv      = "RECENT_GO_MCP_DETAIL_LEVEL"
+	EnabledToolsEnv     = "RECENT_GO_MCP_ENABLED_TOOLS"
+	PackageAllowlistEnv = "RECENT_GO_MCP_PACKAGE_ALLOWLIST"
+	LocaleEnv           = "RECENT_GO_MCP_LOCALE"
+	CacheSizeEnv        = "RECENT_GO_MCP_CACHE_SIZE"
+	VerifiedOnlyEnv     = "RECENT_GO_MCP_VERIFIED_ONLY"
+)
+
+// Config holds every server setting; the zero value of a list means "no restriction"
+type Config struct {
+	Transport Transport `yaml:"transport"`
+	Data      Data      `yaml:"data"`
+	// DetailLevel is the detail of feature listings when a call does not choose one
+	DetailLevel string `yaml:"detail_level"`
+	// EnabledTools limits the registered tools; empty registers all of them
+	EnabledTools []string `yaml:"enabled_tools"`
+	// PackageAllowlist limits the values of the package argument of MCP tools; empty accepts every known package
+	// CLI subcommands and the goreleases package are not restricted
+	PackageAllowlist []string `yaml:"package_allowlist"`
+	// Locale is the language of responses when a call does not choose one
+	Locale string `yaml:"locale"`
+	// CacheSize bounds the number of cached responses and formatted outputs
+	CacheSize int `yaml:"cache_size"`
+	// VerifiedOnly hides entries not checked against the upstream documentation
+	VerifiedOnly bool            `yaml:"verified_only"`
+	Logging      logging.Options `yaml:"logging"`
+}
+
+// Transport selects how MCP clients connect
+type Transport struct {
+	Mode     string `yaml:"mode"`      // stdio or http
+	HTTPAddr string `yaml:"http_addr"` // listen address in http mode
+}
+
+// Data points at release data on disk that replaces the embedded copy
+type Data struct {
+	ReleasesDir string `yaml:"releases_dir"` // one go1.N.json file per release
+	LocalesDir  string `yaml:"locales_dir"`  // one directory of overlay files per locale
+	// CheatsheetFile replaces the themes of the cheatsheet format (layout of data/cheatsheet.json)
+	CheatsheetFile string `yaml:"cheatsheet_file"`
+}
+
+// Default returns the settings used when nothing is configured
+func Default() Config {
+	return Config{
+		Transport: Transport{
+			Mode:     TransportStdio,
+			HTTPAddr: ":8080",
+		},
+		DetailLevel: domain.DetailFull,
+		Locale:      domain.DefaultLocale,
+		CacheSize:   128,
+		Logging:     logging.DefaultOptions(),
+	}
+}
+
+// Load reads the YAML file at path on top of the defaults; an empty path returns the defaults
+// Unknown keys are rejected so that typos do not silently fall back to defaults
+func Load(path string) (Config, error) {
+	cfg := Default()
+	if path == "" {
+		return cfg, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// FromEnv loads the file named by RECENT_GO_MCP_CONFIG, applies the environment overrides and validates the result
+func FromEnv(getenv func(string) string) (Config, error) {
+	cfg, err := Load(getenv(FileEnv))
+	if err != nil {
+		return cfg, err
+	}
+	if cfg, err = cfg.WithEnv(getenv); err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
+}
+
+// WithEnv returns a copy of c overridden by the environment variables that are set
+// Setting RECENT_GO_MCP_HTTP_ADDR alone selects the http transport
+func (c Config) WithEnv(getenv func(string) string) (Config, error) {
+	if addr := getenv(HTTPAddrEnv); addr != "" {
+		c.Transport.Mode = TransportHTTP
+		c.Transport.HTTPAddr = addr
+	}
+	setString(&c.Transport.Mode, getenv(TransportEnv))
+	setString(&c.Data.ReleasesDir, getenv(ReleasesDirEnv))
+	setString(&c.Data.LocalesDir, getenv(LocalesDirEnv))
+	setString(&c.Data.CheatsheetFile, getenv(CheatsheetFileEnv))
+	setString(&c.DetailLevel, getenv(DetailLevelEnv))
+	setString(&c.Locale, getenv(LocaleEnv))
+	if tools := getenv(EnabledToolsEnv); tools != "" {
+		c.EnabledTools = splitList(tools)
+	}
+	if packages := getenv(PackageAllowlistEnv); packages != "" {
+		c.PackageAllowlist = splitList(packages)
+	}
+
+	if size := getenv(CacheSizeEnv); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s %q: %w", CacheSizeEnv, size, err)
+		}
+		c.CacheSize = n
+	}
+	if verifiedOnly := getenv(VerifiedOnlyEnv); verifiedOnly != "" {
+		b, err := strconv.ParseBool(verifiedOnly)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s %q: %w", VerifiedOnlyEnv, verifiedOnly, err)
+		}
+		c.VerifiedOnly = b
+	}
+
+	c.Logging = logging.OptionsFromEnv(c.Logging, getenv)
+	return c, nil
+}
+
+// Validate reports every invalid setting; locales and tool names are checked by the server, which knows its message catalogs and tools
+func (c Config) Validate() error {
+	var errs []error
+	if c.Transport.Mode != TransportStdio && c.Transport.Mode != TransportHTTP {
+		errs = append(errs, fmt.Errorf("unsupported transport %q (use stdio or http)", c.Transport.Mode))
+	}
+	if c.Transport.Mode == TransportHTTP && c.Transport.HTTPAddr == "" {
+		errs = append(errs, errors.New("the http transport requires an address"))
+	}
+	if !domain.IsSupportedDetailLevel(c.DetailLevel) {
+		errs = append(errs, fmt.Errorf("unsupported detail level %q (use %s)", c.DetailLevel, strings.Join(domain.DetailLevels(), ", ")))
+	}
+	if c.CacheSize <= 0 {
+		errs = append(errs, fmt.Errorf("cache size must be positive, got %d", c.CacheSize))
+	}
+	if _, err := logging.ParseLevel(c.Logging.Level); err != nil {
+		errs = append(errs, err)
+	}
+	if !slices.Contains([]string{logging.FormatJSON, logging.FormatText}, c.Logging.Format) {
+		errs = append(errs, fmt.Errorf("unsupported log format %q (use json or text)", c.Logging.Format))
+	}
+	return errors.Join(errs...)
+}
+
+// setString overrides dst when value is set
+func setString(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
+
+// splitList splits a comma-separated environment variable, dropping blanks
+func splitList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/logging"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("file overrides defaults", func(t *testing.T) {
+		path := writeConfig(t, `
+transport:
+  mode: http
+data:
+  releases_dir: /srv/go-releases
+detail_level: brief
+enabled_tools: [go-updates, go-versions]
+package_allowlist: [net/http, slices]
+cache_size: 32
+logging:
+  level: debug
+`)
+		cfg, err := Load(path)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+
+		if cfg.Transport.Mode != TransportHTTP || cfg.Transport.HTTPAddr != ":8080" {
+			t.Errorf("expected http mode with the default address, got %+v", cfg.Transport)
+		}
+		if cfg.Data.ReleasesDir != "/srv/go-releases" || cfg.DetailLevel != "brief" || cfg.CacheSize != 32 {
+			t.Errorf("unexpected config %+v", cfg)
+		}
+		if !slices.Equal(cfg.EnabledTools, []string{"go-updates", "go-versions"}) || !slices.Equal(cfg.PackageAllowlist, []string{"net/http", "slices"}) {
+			t.Errorf("unexpected lists %v %v", cfg.EnabledTools, cfg.PackageAllowlist)
+		}
+		// Unset keys keep their defaults
+		if cfg.Locale != "en" || cfg.Logging != (logging.Options{Level: "debug", Format: logging.FormatJSON}) {
+			t.Errorf("expected defaults for unset keys, got %+v", cfg)
+		}
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := Load(writeConfig(t, "cache_sise: 10\n"))
+		if err == nil || !strings.Contains(err.Error(), "cache_sise") {
+			t.Errorf("expected an unknown key error, got %v", err)
+		}
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		cfg, err := Load("")
+		if err != nil || cfg.Transport.Mode != TransportStdio {
+			t.Errorf("expected defaults, got %+v (%v)", cfg, err)
+		}
+	})
+}
+
+func TestConfig_WithEnv(t *testing.T) {
+	env := map[string]string{
+		HTTPAddrEnv:         "127.0.0.1:9000",
+		EnabledToolsEnv:     "go-updates, go-versions,",
+		PackageAllowlistEnv: "slices",
+		LocaleEnv:           "ja",
+		CacheSizeEnv:        "64",
+		VerifiedOnlyEnv:     "true",
+		CheatsheetFileEnv:   "/srv/cheatsheet.json",
+		logging.FormatEnv:   "text",
+	}
+	cfg, err := Default().WithEnv(func(key string) string { return env[key] })
+	if err != nil {
+		t.Fatalf("WithEnv failed: %v", err)
+	}
+
+	if cfg.Transport.Mode != TransportHTTP || cfg.Transport.HTTPAddr != "127.0.0.1:9000" {
+		t.Errorf("expected the address to select http mode, got %+v", cfg.Transport)
+	}
+	if !slices.Equal(cfg.EnabledTools, []string{"go-updates", "go-versions"}) || !slices.Equal(cfg.PackageAllowlist, []string{"slices"}) {
+		t.Errorf("unexpected lists %v %v", cfg.EnabledTools, cfg.PackageAllowlist)
+	}
+	if cfg.Locale != "ja" || cfg.CacheSize != 64 || !cfg.VerifiedOnly || cfg.Logging.Format != "text" || cfg.Data.CheatsheetFile != "/srv/cheatsheet.json" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	if _, err := Default().WithEnv(func(key string) string {
+		if key == CacheSizeEnv {
+			return "many"
+		}
+		return ""
+	}); err == nil || !strings.Contains(err.Error(), CacheSizeEnv) {
+		t.Errorf("expected an invalid cache size error, got %v", err)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Errorf("expected the defaults to be valid, got %v", err)
+	}
+
+	cfg := Default()
+	cfg.Transport.Mode = "grpc"
+	cfg.DetailLevel = "verbose"
+	cfg.CacheSize = 0
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, want := range []string{`transport "grpc"`, `detail level "verbose"`, "cache size"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	path := writeConfig(t, "locale: ja\n")
+	env := map[string]string{FileEnv: path, DetailLevelEnv: "brief"}
+
+	cfg, err := FromEnv(func(key string) string { return env[key] })
+	if err != nil {
+		t.Fatalf("FromEnv failed: %v", err)
+	}
+	if cfg.Locale != "ja" || cfg.DetailLevel != "brief" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	env[DetailLevelEnv] = "verbose"
+	if _, err := FromEnv(func(key string) string { return env[key] }); err == nil {
+		t.Error("expected a validation error")
+	}
+}
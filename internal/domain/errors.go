@@ -17,6 +17,53 @@ const (
 	ErrTypeInvalidInput ErrType = "invalid_input"
 )
 
+// errTypeGuidance holds the user-facing message and retry guidance of an error type
+type errTypeGuidance struct {
+	message   string
+	retryable bool
+	retryHint string
+}
+
+// errTypeGuidances maps every error type to consistent wording for clients
+var errTypeGuidances = map[ErrType]errTypeGuidance{
+	ErrTypeRepository:   {message: "Release data is unavailable", retryable: true, retryHint: "Retry later; the release data may be reloading"},
+	ErrTypeVersion:      {message: "Invalid Go version", retryable: false, retryHint: "Do not retry unchanged; pass a version such as \"1.22\""},
+	ErrTypeService:      {message: "The request could not be completed", retryable: true, retryHint: "Retry once; report the problem if it persists"},
+	ErrTypeValidation:   {message: "Invalid input", retryable: false, retryHint: "Do not retry unchanged; fix the argument named in the error"},
+	ErrTypeNotFound:     {message: "Not found", retryable: false, retryHint: "Do not retry unchanged; use one of the suggested values"},
+	ErrTypeInvalidInput: {message: "Invalid input", retryable: false, retryHint: "Do not retry unchanged; fix the argument named in the error"},
+}
+
+// UserMessage returns the user-facing summary of the error type
+func (t ErrType) UserMessage() string {
+	if guidance, exists := errTypeGuidances[t]; exists {
+		return guidance.message
+	}
+	return "Unexpected error"
+}
+
+// Retryable reports whether repeating the same request may succeed
+func (t ErrType) Retryable() bool {
+	return errTypeGuidances[t].retryable
+}
+
+// RetryHint tells clients whether and how to retry after an error of this type
+func (t ErrType) RetryHint() string {
+	if guidance, exists := errTypeGuidances[t]; exists {
+		return guidance.retryHint
+	}
+	return "Retry once; report the problem if it persists"
+}
+
+// IsClientError reports whether the error type is caused by the request rather than the server
+func (t ErrType) IsClientError() bool {
+	switch t {
+	case ErrTypeValidation, ErrTypeInvalidInput, ErrTypeNotFound, ErrTypeVersion:
+		return true
+	}
+	return false
+}
+
 // ApplicationError represents a structured error with context
 type ApplicationError struct {
 	Type      ErrType
@@ -16,6 +16,16 @@ type ReleaseRepository interface {
 	// Note: Returned pointers should be treated as read-only to maintain data integrity
 	GetReleasesUpToVersion(ctx context.Context, targetVersion string) ([]*GoRelease, error)
 
+	// GetReleasesAfterVersion returns all releases newer than the specified version, oldest first
+	// Note: Returned pointers should be treated as read-only to maintain data integrity
+	GetReleasesAfterVersion(ctx context.Context, version string) ([]*GoRelease, error)
+
+	// GetPackageVersions returns the versions that changed the given package, oldest first
+	GetPackageVersions(ctx context.Context, packageName string) ([]string, error)
+
+	// GetPackagesUpToVersion returns the sorted import paths changed in any release up to the specified version
+	GetPackagesUpToVersion(ctx context.Context, targetVersion string) ([]string, error)
+
 	// GetOldestVersion returns the oldest available version
 	GetOldestVersion(ctx context.Context) (string, error)
 
@@ -23,6 +33,17 @@ type ReleaseRepository interface {
 	GetLatestVersion(ctx context.Context) (string, error)
 }
 
+// ReloadableRepository is a ReleaseRepository whose data can be reloaded at runtime
+type ReloadableRepository interface {
+	ReleaseRepository
+
+	// Reload re-reads release data from the underlying source
+	Reload(ctx context.Context) error
+
+	// OnReload registers a callback invoked after every successful reload
+	OnReload(fn func())
+}
+
 // VersionComparator handles version comparison logic
 type VersionComparator interface {
 	// Compare compares two version strings
@@ -34,10 +55,101 @@ type VersionComparator interface {
 type FeatureService interface {
 	// GetFeaturesForVersion returns all features available up to the specified version
 	GetFeaturesForVersion(ctx context.Context, targetVersion string, packageName string) (*FeatureResponse, error)
+
+	// QueryFeatures returns the features matching query
+	QueryFeatures(ctx context.Context, query FeatureQuery) (*FeatureResponse, error)
+}
+
+// MigrationService provides upgrade guidance between two versions
+type MigrationService interface {
+	// GetMigrationGuide returns the breaking changes and deprecations introduced after fromVersion up to toVersion
+	// An empty toVersion means the latest available version; experimental changes are skipped unless includeExperimental is set
+	GetMigrationGuide(ctx context.Context, fromVersion, toVersion string, includeExperimental bool) (*MigrationGuide, error)
+}
+
+// GodebugService provides GODEBUG defaults tied to the go directive
+type GodebugService interface {
+	// GetGodebugReport returns the effective GODEBUG defaults for goVersion
+	// When compareTo is not empty, the settings whose default differs from compareTo are reported as well
+	// GODEBUG settings are never experimental, so there is nothing to opt into
+	GetGodebugReport(ctx context.Context, goVersion, compareTo string) (*GodebugReport, error)
+}
+
+// DeprecationService provides deprecated APIs and their replacements
+type DeprecationService interface {
+	// ListDeprecations returns the APIs deprecated in or before the specified version
+	// Deprecations derived from experimental package changes are skipped unless includeExperimental is set
+	ListDeprecations(ctx context.Context, version string, includeExperimental bool) (*DeprecationReport, error)
+
+	// LookupDeprecation returns the deprecations matching a symbol (e.g., "io/ioutil.ReadAll" or "ioutil.ReadAll")
+	// A symbol that is not deprecated yields an empty report rather than an error
+	LookupDeprecation(ctx context.Context, symbol string, includeExperimental bool) (*DeprecationReport, error)
+}
+
+// PlatformService provides port support and minimum OS versions per release
+type PlatformService interface {
+	// GetPlatformSupport returns the platform status for a version, optionally filtered by goos and goarch
+	GetPlatformSupport(ctx context.Context, version, goos, goarch string) (*PlatformReport, error)
+}
+
+// ToolchainService provides go command, flag and environment variable changes
+type ToolchainService interface {
+	// GetToolchainUpdates returns the toolchain changes up to version, optionally filtered by command (e.g., "go test" or "vet")
+	GetToolchainUpdates(ctx context.Context, version, command string) (*ToolchainReport, error)
+}
+
+// ComparisonService compares the features available in two Go versions
+type ComparisonService interface {
+	// CompareVersions returns side-by-side feature counts for two versions given in any order
+	// Experimental changes are counted only when includeExperimental is set, like in FeatureQuery
+	CompareVersions(ctx context.Context, version, otherVersion string, includeExperimental bool) (*VersionComparison, error)
+}
+
+// PackageHistoryService provides the release timeline of a standard library package
+type PackageHistoryService interface {
+	// GetPackageHistory returns every release that changed the queried package, marking releases newer than its version as unavailable
+	GetPackageHistory(ctx context.Context, query PackageHistoryQuery) (*PackageHistory, error)
+}
+
+// VersionService lists the Go releases the server knows about
+type VersionService interface {
+	// ListVersions returns every available release with its date, summary and change counts
+	// Experimental changes are counted only when includeExperimental is set, so counts match CompareVersions
+	ListVersions(ctx context.Context, includeExperimental bool) (*VersionCatalog, error)
 }
 
 // ResponseFormatter handles formatting of responses
 type ResponseFormatter interface {
 	// FormatAsText formats a FeatureResponse as human-readable text
 	FormatAsText(response *FeatureResponse, version string, packageName string) string
+
+	// FormatMigrationGuide formats a MigrationGuide as a step-by-step document
+	FormatMigrationGuide(guide *MigrationGuide) string
+
+	// FormatGodebugReport formats a GodebugReport as human-readable text
+	FormatGodebugReport(report *GodebugReport) string
+
+	// FormatDeprecationReport formats a DeprecationReport as human-readable text
+	FormatDeprecationReport(report *DeprecationReport) string
+
+	// FormatPlatformReport formats a PlatformReport as human-readable text
+	FormatPlatformReport(report *PlatformReport) string
+
+	// FormatToolchainReport formats a ToolchainReport as human-readable text
+	FormatToolchainReport(report *ToolchainReport) string
+
+	// FormatVersionComparison formats a VersionComparison as side-by-side tables
+	FormatVersionComparison(comparison *VersionComparison) string
+
+	// FormatPackageHistory formats a PackageHistory as a chronological timeline
+	FormatPackageHistory(history *PackageHistory) string
+
+	// FormatVersionCatalog formats a VersionCatalog as a table of releases
+	FormatVersionCatalog(catalog *VersionCatalog) string
+}
+
+// FeatureFormatter renders a FeatureResponse in one output format (e.g., Markdown or JSON)
+type FeatureFormatter interface {
+	// Format renders response; opts carry the query and the per-request presentation settings
+	Format(response *FeatureResponse, opts FormatOptions) (string, error)
 }
@@ -1,14 +1,24 @@
 package domain
 
-import "time"
+import (
+	"slices"
+	"strconv"
+	"time"
+)
 
 // GoRelease represents a Go version release with its updates
 type GoRelease struct {
-	Version     string                     `json:"version"`
-	ReleaseDate time.Time                  `json:"release_date"`
-	Summary     string                     `json:"summary"`
-	Changes     []Change                   `json:"changes"`
-	Packages    map[string][]PackageChange `json:"packages"`
+	Version     string    `json:"version"`
+	ReleaseDate time.Time `json:"release_date"`
+	Summary     string    `json:"summary"`
+	// SummaryTranslations maps a locale (e.g., "ja") to a translated summary; English is the fallback
+	SummaryTranslations map[string]string          `json:"summary_translations,omitempty"`
+	Changes             []Change                   `json:"changes"`
+	Packages            map[string][]PackageChange `json:"packages"`
+	Godebug             []GodebugSetting           `json:"godebug,omitempty"`
+	Deprecations        []Deprecation              `json:"deprecations,omitempty"`
+	Platforms           []PlatformChange           `json:"platforms,omitempty"`
+	Toolchain           []ToolchainChange          `json:"toolchain,omitempty"`
 }
 
 // Change represents a general change in a Go release
@@ -16,6 +26,18 @@ type Change struct {
 	Category    string `json:"category"` // "language", "runtime", "toolchain", "performance"
 	Description string `json:"description"`
 	Impact      string `json:"impact"` // "breaking", "enhancement", "deprecation", "new"
+	// MigrationNotes explains how to adapt existing code (mainly for "breaking" and "deprecation")
+	MigrationNotes string `json:"migration_notes,omitempty"`
+	// Source points to the upstream documentation the entry was derived from
+	Source *SourceRef `json:"source,omitempty"`
+	Verification
+	Confidence string `json:"confidence,omitempty"` // "high", "medium", "low"
+	// Experimental marks entries that are opt-in previews in this release (e.g., behind GOEXPERIMENT)
+	Experimental bool   `json:"experimental,omitempty"`
+	GoExperiment string `json:"goexperiment,omitempty"`  // GOEXPERIMENT value enabling the feature (e.g., "rangefunc")
+	StabilizedIn string `json:"stabilized_in,omitempty"` // Release that made the feature stable and enabled by default
+	// DescriptionTranslations maps a locale (e.g., "ja") to a translated description; English is the fallback
+	DescriptionTranslations map[string]string `json:"description_translations,omitempty"`
 }
 
 // PackageChange represents changes specific to a standard library package
@@ -25,6 +47,94 @@ type PackageChange struct {
 	Description string `json:"description"`
 	Impact      string `json:"impact"`
 	Example     string `json:"example,omitempty"`
+	// MigrationNotes explains how to adapt existing code (mainly for "breaking" and "deprecation")
+	MigrationNotes string `json:"migration_notes,omitempty"`
+	// Source points to the upstream documentation the entry was derived from
+	Source *SourceRef `json:"source,omitempty"`
+	Verification
+	Confidence string `json:"confidence,omitempty"` // "high", "medium", "low"
+	// Experimental marks entries that are opt-in previews in this release (e.g., behind GOEXPERIMENT)
+	Experimental bool   `json:"experimental,omitempty"`
+	GoExperiment string `json:"goexperiment,omitempty"`  // GOEXPERIMENT value enabling the feature (e.g., "rangefunc")
+	StabilizedIn string `json:"stabilized_in,omitempty"` // Release that made the feature stable and enabled by default
+	// DescriptionTranslations maps a locale (e.g., "ja") to a translated description; English is the fallback
+	DescriptionTranslations map[string]string `json:"description_translations,omitempty"`
+}
+
+// Verification records whether an entry was checked against the upstream documentation, and by whom
+// It is embedded in every kind of release entry
+type Verification struct {
+	Verified   bool   `json:"verified,omitempty"`
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+}
+
+// SourceRef records where a change is documented upstream
+type SourceRef struct {
+	Anchor string `json:"anchor,omitempty"`  // Anchor in the go.dev/doc/go1.N release notes (e.g., "enhanced_routing_patterns")
+	Issue  int    `json:"issue,omitempty"`   // Proposal or issue number on go.dev/issue
+	PkgDoc string `json:"pkg_doc,omitempty"` // pkg.go.dev path, optionally with a symbol fragment (e.g., "net/http#ServeMux")
+}
+
+// ReleaseNotesURL returns the release notes link for the given version, or "" without an anchor
+func (s SourceRef) ReleaseNotesURL(version string) string {
+	if s.Anchor == "" {
+		return ""
+	}
+	return "https://go.dev/doc/go" + version + "#" + s.Anchor
+}
+
+// IssueURL returns the proposal or issue link, or "" without an issue number
+func (s SourceRef) IssueURL() string {
+	if s.Issue == 0 {
+		return ""
+	}
+	return "https://go.dev/issue/" + strconv.Itoa(s.Issue)
+}
+
+// PkgDocURL returns the pkg.go.dev link, or "" without a documentation path
+func (s SourceRef) PkgDocURL() string {
+	if s.PkgDoc == "" {
+		return ""
+	}
+	return "https://pkg.go.dev/" + s.PkgDoc
+}
+
+// GodebugSetting represents a GODEBUG setting introduced or whose default changed in a release
+type GodebugSetting struct {
+	Name    string `json:"name"`
+	Default string `json:"default"` // Default for modules whose go directive is this release or later
+	// PreviousDefault is the default for older go directives, set when the setting was introduced with a behavior change
+	PreviousDefault string `json:"previous_default,omitempty"`
+	Description     string `json:"description"`
+	Verification
+}
+
+// Deprecation represents an API deprecated in a release and its recommended replacement
+type Deprecation struct {
+	Symbol      string `json:"symbol"` // Import path, optionally followed by ".Name" (e.g., "io/ioutil.ReadAll")
+	Replacement string `json:"replacement,omitempty"`
+	Description string `json:"description"`
+	Verification
+}
+
+// PlatformChange represents a port or operating system requirement change in a release
+type PlatformChange struct {
+	GOOS         string `json:"goos"`
+	GOARCH       string `json:"goarch,omitempty"` // Empty applies to every architecture of GOOS
+	Status       string `json:"status"`           // "added", "experimental", "removed", "broken", "updated"
+	MinOSVersion string `json:"min_os_version,omitempty"`
+	Description  string `json:"description"`
+	Verification
+}
+
+// ToolchainChange represents a change to a go command, flag, directive or environment variable
+type ToolchainChange struct {
+	Command     string `json:"command"`           // e.g., "go test", "go vet", "go.mod"; "go" for flags and variables of every command
+	Flag        string `json:"flag,omitempty"`    // Flag, argument form or directive (e.g., "-fuzz", "pkg@version")
+	EnvVar      string `json:"env_var,omitempty"` // Environment variable (e.g., "GOTOOLCHAIN")
+	Status      string `json:"status"`            // "added", "changed", "deprecated", "removed"
+	Description string `json:"description"`
+	Verification
 }
 
 // FeatureResponse represents the response containing features available up to a version
@@ -37,4 +147,249 @@ type FeatureResponse struct {
 	// Version-specific data for formatted output
 	VersionChanges  map[string][]Change                   `json:"-"`
 	VersionPackages map[string]map[string][]PackageChange `json:"-"`
+	// Upcoming lists releases after ToVersion when requested; their features are not usable yet
+	Upcoming []UpcomingRelease `json:"upcoming,omitempty"`
+	// SearchTerm is set when the response is reduced to the entries mentioning it, which changes the summary
+	SearchTerm string `json:"search_term,omitempty"`
+}
+
+// FeatureQuery describes a feature lookup
+type FeatureQuery struct {
+	Version string // Go version the project is using
+	Package string // Optional import path filter
+	// IncludeUpcoming adds the features of releases after Version, kept apart from the usable ones
+	IncludeUpcoming bool
+	// IncludeExperimental keeps experimental entries, which are hidden by default
+	IncludeExperimental bool
+}
+
+// PackageHistoryQuery describes a package timeline lookup
+type PackageHistoryQuery struct {
+	Package string // Standard library import path
+	Version string // Optional Go version the project is using; later releases are marked as unavailable
+	// IncludeExperimental keeps experimental entries, which are hidden by default like in FeatureQuery
+	IncludeExperimental bool
+}
+
+// Detail levels of feature listings
+const (
+	// DetailFull lists every change with its code example and source footnotes
+	DetailFull = "full"
+	// DetailBrief lists every change on a single line, without code examples or source footnotes
+	DetailBrief = "brief"
+)
+
+// DetailLevels returns the supported detail levels
+func DetailLevels() []string {
+	return []string{DetailBrief, DetailFull}
+}
+
+// IsSupportedDetailLevel reports whether level is one of DetailLevels
+func IsSupportedDetailLevel(level string) bool {
+	return slices.Contains(DetailLevels(), level)
+}
+
+// DefaultLocale is the locale of the built-in English text; other locales are defined by the formatter's message catalogs
+const DefaultLocale = "en"
+
+// FormatOptions controls how a FeatureFormatter renders a response
+type FormatOptions struct {
+	Version string // Go version the response was queried for
+	Package string // Optional import path the response was filtered by
+	Locale  string // Language of fixed strings and translated descriptions; empty selects English
+	// DetailLevel is "full" (the default) or "brief", which lists one line per change without source links
+	DetailLevel string
+	// IncludeExamples adds the code examples of package changes
+	IncludeExamples bool
+}
+
+// CheatsheetTheme groups the rows of the cheatsheet format under a heading (e.g., "errors")
+type CheatsheetTheme struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	// TitleTranslations maps a locale (e.g., "ja") to a translated title; English is the fallback
+	TitleTranslations map[string]string `json:"title_translations,omitempty"`
+	Rules             []CheatsheetRule  `json:"rules"` // Rows in display order
+}
+
+// CheatsheetRule maps a package change with an example to the older pattern it replaces
+type CheatsheetRule struct {
+	Package  string `json:"package"`
+	Function string `json:"function,omitempty"` // Empty matches the package-level entry
+	Replaces string `json:"replaces"`           // Markdown describing the older pattern
+}
+
+// UpcomingRelease represents the features a project gains by upgrading to a newer release
+type UpcomingRelease struct {
+	Version       string                     `json:"version"`
+	Justification string                     `json:"justification"` // Why upgrading to this release is worthwhile
+	Changes       []Change                   `json:"changes"`
+	Packages      map[string][]PackageChange `json:"packages,omitempty"`
+}
+
+// MigrationGuide represents the breaking changes and deprecations to address when upgrading
+type MigrationGuide struct {
+	FromVersion string          `json:"from_version"`
+	ToVersion   string          `json:"to_version"`
+	Summary     string          `json:"summary"`
+	Steps       []MigrationStep `json:"steps"`
+}
+
+// MigrationStep represents a single breaking change or deprecation in a MigrationGuide
+type MigrationStep struct {
+	Version        string `json:"version"`
+	Category       string `json:"category"` // Change category, or "library" for package changes
+	Package        string `json:"package,omitempty"`
+	Symbol         string `json:"symbol,omitempty"`
+	Impact         string `json:"impact"` // "breaking" or "deprecation"
+	Description    string `json:"description"`
+	MigrationNotes string `json:"migration_notes,omitempty"`
+	WhatToCheck    string `json:"what_to_check"`
+}
+
+// GodebugReport represents the effective GODEBUG defaults for a go directive version
+type GodebugReport struct {
+	GoVersion string          `json:"go_version"`
+	CompareTo string          `json:"compare_to,omitempty"`
+	Summary   string          `json:"summary"`
+	Settings  []GodebugValue  `json:"settings"`
+	Changes   []GodebugChange `json:"changes,omitempty"`
+}
+
+// GodebugValue represents the effective default of a GODEBUG setting
+type GodebugValue struct {
+	Name         string `json:"name"`
+	Default      string `json:"default"`
+	IntroducedIn string `json:"introduced_in"`
+	// DefaultSince is the go version that set the current default, empty when the pre-introduction default applies
+	DefaultSince string `json:"default_since,omitempty"`
+	Description  string `json:"description"`
+}
+
+// GodebugChange represents a GODEBUG default that differs between two go directive versions
+type GodebugChange struct {
+	Name        string `json:"name"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	ChangedIn   string `json:"changed_in"`
+	Description string `json:"description"`
+}
+
+// DeprecationReport represents the deprecations matching a version listing or a symbol lookup
+type DeprecationReport struct {
+	Version      string              `json:"version,omitempty"`
+	Symbol       string              `json:"symbol,omitempty"`
+	Summary      string              `json:"summary"`
+	Deprecations []DeprecationRecord `json:"deprecations"`
+}
+
+// DeprecationRecord represents a deprecated API together with the release that deprecated it
+type DeprecationRecord struct {
+	Symbol       string `json:"symbol"`
+	Package      string `json:"package"`
+	DeprecatedIn string `json:"deprecated_in"`
+	Replacement  string `json:"replacement,omitempty"`
+	Description  string `json:"description"`
+}
+
+// PlatformReport represents the port support and minimum OS versions for a release
+type PlatformReport struct {
+	Version      string          `json:"version"`
+	GOOS         string          `json:"goos,omitempty"`
+	GOARCH       string          `json:"goarch,omitempty"`
+	Summary      string          `json:"summary"`
+	Ports        []PortStatus    `json:"ports"`
+	Requirements []OSRequirement `json:"requirements"`
+	// LaterPort is the first later release adding a requested port that Version does not have yet
+	LaterPort *PortStatus `json:"later_port,omitempty"`
+}
+
+// PortStatus represents the effective status of a port in a release
+type PortStatus struct {
+	GOOS        string `json:"goos"`
+	GOARCH      string `json:"goarch,omitempty"` // Empty applies to every architecture of GOOS
+	Status      string `json:"status"`           // "supported", "experimental", "removed", "broken"
+	Since       string `json:"since"`
+	Description string `json:"description"`
+}
+
+// OSRequirement represents the minimum operating system version required by a release
+type OSRequirement struct {
+	GOOS         string `json:"goos"`
+	MinOSVersion string `json:"min_os_version"`
+	Since        string `json:"since"`
+	Description  string `json:"description"`
+}
+
+// ToolchainReport represents the toolchain changes available up to a version
+type ToolchainReport struct {
+	Version string           `json:"version"`
+	Command string           `json:"command,omitempty"`
+	Summary string           `json:"summary"`
+	Entries []ToolchainEntry `json:"entries"`
+}
+
+// ToolchainEntry represents a toolchain change together with the release that made it
+type ToolchainEntry struct {
+	ToolchainChange
+	Version string `json:"version"`
+}
+
+// VersionComparison represents the features available in an older and a newer Go version side by side
+type VersionComparison struct {
+	FromVersion string           `json:"from_version"` // The older version
+	ToVersion   string           `json:"to_version"`   // The newer version
+	Summary     string           `json:"summary"`
+	From        ComparisonTotals `json:"from"`
+	To          ComparisonTotals `json:"to"`
+	// Packages lists the packages changed after FromVersion up to ToVersion, sorted by import path
+	Packages []PackageComparison `json:"packages"`
+}
+
+// ComparisonTotals represents cumulative feature counts up to a version
+type ComparisonTotals struct {
+	LanguageChanges int `json:"language_changes"`
+	BreakingChanges int `json:"breaking_changes"`
+	NewAPIs         int `json:"new_apis"` // Package changes with impact "new" that name a function or type
+	PackagesTouched int `json:"packages_touched"`
+}
+
+// PackageComparison represents one package touched between the compared versions
+type PackageComparison struct {
+	Package     string   `json:"package"`
+	FromNewAPIs int      `json:"from_new_apis"`
+	ToNewAPIs   int      `json:"to_new_apis"`
+	ChangedIn   []string `json:"changed_in"` // Versions after FromVersion up to ToVersion that changed the package
+}
+
+// PackageHistory represents every release that changed a standard library package
+type PackageHistory struct {
+	Package string                `json:"package"`
+	Version string                `json:"version,omitempty"` // The caller's Go version; empty marks every release as available
+	Summary string                `json:"summary"`
+	Entries []PackageHistoryEntry `json:"entries"` // Oldest first
+}
+
+// PackageHistoryEntry represents the changes a release made to a package
+type PackageHistoryEntry struct {
+	Version   string          `json:"version"`
+	Available bool            `json:"available"` // False for releases newer than the caller's version
+	Changes   []PackageChange `json:"changes"`
+}
+
+// VersionCatalog represents every embedded Go release with per-release counts
+type VersionCatalog struct {
+	OldestVersion string            `json:"oldest_version"`
+	LatestVersion string            `json:"latest_version"`
+	Summary       string            `json:"summary"`
+	Releases      []ReleaseOverview `json:"releases"` // Newest first
+}
+
+// ReleaseOverview represents one release in a VersionCatalog
+type ReleaseOverview struct {
+	Version             string            `json:"version"`
+	ReleaseDate         time.Time         `json:"release_date"`
+	Summary             string            `json:"summary"`
+	SummaryTranslations map[string]string `json:"summary_translations,omitempty"`
+	Counts              ComparisonTotals  `json:"counts"` // Changes made by this release alone
 }
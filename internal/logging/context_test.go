@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestContextHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.log")
+	logger, closeFn, err := New(Options{Level: "info", Format: FormatJSON, File: path})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	ctx := WithSessionID(WithRequestID(context.Background(), "req-1"), "session-1")
+	logger.With("component", "repository").InfoContext(ctx, "with ids")
+	logger.Info("without ids")
+	closeFn()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 records, got %q", data)
+	}
+
+	var withIDs, withoutIDs map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &withIDs); err != nil {
+		t.Fatalf("invalid JSON record: %v", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &withoutIDs); err != nil {
+		t.Fatalf("invalid JSON record: %v", err)
+	}
+
+	if withIDs[RequestIDKey] != "req-1" || withIDs[SessionIDKey] != "session-1" || withIDs["component"] != "repository" {
+		t.Errorf("expected correlation IDs on the derived logger, got %v", withIDs)
+	}
+	if _, ok := withoutIDs[RequestIDKey]; ok {
+		t.Errorf("expected no request ID without a context, got %v", withoutIDs)
+	}
+}
+
+func TestNewRequestID(t *testing.T) {
+	first, second := NewRequestID(), NewRequestID()
+	if len(first) != 16 || first == second {
+		t.Errorf("expected distinct 16 character IDs, got %q and %q", first, second)
+	}
+}
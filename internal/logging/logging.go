@@ -0,0 +1,103 @@
+// Package logging builds the server's slog logger and carries per-request correlation IDs through contexts
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Log output formats
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Environment variables read by OptionsFromEnv
+const (
+	LevelEnv  = "RECENT_GO_MCP_LOG_LEVEL"
+	FormatEnv = "RECENT_GO_MCP_LOG_FORMAT"
+	FileEnv   = "RECENT_GO_MCP_LOG_FILE"
+)
+
+// Options selects the level, format and destination of log output
+type Options struct {
+	Level  string `yaml:"level"`  // debug, info, warn or error
+	Format string `yaml:"format"` // json or text
+	File   string `yaml:"file"`   // appended to when set; stderr otherwise (stdout carries the MCP protocol)
+}
+
+// DefaultOptions returns JSON logs at info level on stderr
+func DefaultOptions() Options {
+	return Options{
+		Level:  "info",
+		Format: FormatJSON,
+	}
+}
+
+// OptionsFromEnv overrides the fields of base whose environment variable is set
+func OptionsFromEnv(base Options, getenv func(string) string) Options {
+	if level := getenv(LevelEnv); level != "" {
+		base.Level = level
+	}
+	if format := getenv(FormatEnv); format != "" {
+		base.Format = format
+	}
+	if file := getenv(FileEnv); file != "" {
+		base.File = file
+	}
+	return base
+}
+
+// ParseLevel converts a level name into a slog.Level
+func ParseLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return 0, fmt.Errorf("unsupported log level %q (use debug, info, warn or error)", level)
+	}
+	return l, nil
+}
+
+// New creates a logger from opts
+// Every record is annotated with the request and session IDs found in the context passed to the *Context logging methods
+// The returned function closes the log file, if any
+func New(opts Options) (*slog.Logger, func() error, error) {
+	level, err := ParseLevel(opts.Level)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var out io.Writer = os.Stderr
+	closeFn := func() error { return nil }
+	if opts.File != "" {
+		file, err := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		out = file
+		closeFn = file.Close
+	}
+
+	handler, err := newHandler(out, opts.Format, level)
+	if err != nil {
+		closeFn()
+		return nil, nil, err
+	}
+	return slog.New(handler), closeFn, nil
+}
+
+// newHandler creates the format handler wrapped with the correlation ID handler
+func newHandler(out io.Writer, format string, level slog.Level) (slog.Handler, error) {
+	handlerOpts := &slog.HandlerOptions{Level: level}
+
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		return contextHandler{slog.NewJSONHandler(out, handlerOpts)}, nil
+	case FormatText:
+		return contextHandler{slog.NewTextHandler(out, handlerOpts)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported log format %q (use json or text)", format)
+	}
+}
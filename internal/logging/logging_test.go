@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOptionsFromEnv(t *testing.T) {
+	env := map[string]string{LevelEnv: "debug", FileEnv: "/tmp/server.log"}
+	got := OptionsFromEnv(DefaultOptions(), func(key string) string { return env[key] })
+
+	want := Options{Level: "debug", Format: FormatJSON, File: "/tmp/server.log"}
+	if got != want {
+		t.Errorf("OptionsFromEnv() = %+v, want %+v", got, want)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("text format to file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "server.log")
+		logger, closeFn, err := New(Options{Level: "warn", Format: "text", File: path})
+		if err != nil {
+			t.Fatalf("New failed: %v", err)
+		}
+
+		logger.Info("hidden")
+		logger.Warn("shown", "version", "1.22")
+		if err := closeFn(); err != nil {
+			t.Fatalf("close failed: %v", err)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read log file: %v", err)
+		}
+		if strings.Contains(string(data), "hidden") || !strings.Contains(string(data), "msg=shown version=1.22") {
+			t.Errorf("unexpected log output %q", data)
+		}
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		if _, _, err := New(Options{Level: "verbose", Format: FormatJSON}); err == nil || !strings.Contains(err.Error(), "log level") {
+			t.Errorf("expected a level error, got %v", err)
+		}
+		if _, _, err := New(Options{Level: "info", Format: "xml"}); err == nil || !strings.Contains(err.Error(), "log format") {
+			t.Errorf("expected a format error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// mcpEndpoint is the path of the streamable HTTP MCP endpoint
+const mcpEndpoint = "/mcp"
+
+// shutdownTimeout bounds how long in-flight HTTP requests may take after a shutdown signal
+const shutdownTimeout = 10 * time.Second
+
+// readiness is the body of /readyz
+type readiness struct {
+	Status        string `json:"status"`
+	Versions      int    `json:"versions"`
+	OldestVersion string `json:"oldest_version,omitempty"`
+	LatestVersion string `json:"latest_version,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// HTTPHandler routes the MCP endpoint next to the operational endpoints
+// metrics serves /metrics in the Prometheus text format; /metrics is not routed when it is nil
+func (m *Server) HTTPHandler(s *server.MCPServer, metrics http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(mcpEndpoint, server.NewStreamableHTTPServer(s, server.WithEndpointPath(mcpEndpoint)))
+	if metrics != nil {
+		mux.Handle("GET /metrics", metrics)
+	}
+	mux.HandleFunc("GET /healthz", m.handleHealthz)
+	mux.HandleFunc("GET /readyz", m.handleReadyz)
+	return mux
+}
+
+// handleHealthz reports that the process is alive
+func (m *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	m.writeHealth(w, r, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleReadyz reports whether the release data is loaded and how many versions it covers
+func (m *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	releases, err := m.repository.GetAllReleases(r.Context())
+	if err != nil {
+		m.writeHealth(w, r, http.StatusServiceUnavailable, readiness{Status: "unavailable", Error: err.Error()})
+		return
+	}
+	if len(releases) == 0 {
+		m.writeHealth(w, r, http.StatusServiceUnavailable, readiness{Status: "unavailable", Error: "no release data loaded"})
+		return
+	}
+
+	// Releases are ordered newest first
+	m.writeHealth(w, r, http.StatusOK, readiness{
+		Status:        "ready",
+		Versions:      len(releases),
+		OldestVersion: releases[len(releases)-1].Version,
+		LatestVersion: releases[0].Version,
+	})
+}
+
+// writeHealth writes a JSON health response
+func (m *Server) writeHealth(w http.ResponseWriter, r *http.Request, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		m.logger.WarnContext(r.Context(), "Failed to write health response", "error", err)
+	}
+}
+
+// ListenAndServe serves handler on addr until ctx is canceled, then drains in-flight requests
+func ListenAndServe(ctx context.Context, addr string, handler http.Handler) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	slog.Default().Info("Serving MCP over HTTP", "address", listener.Addr().String(), "endpoint", mcpEndpoint)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/telemetry"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	m, err := New(config.Default())
+	if err != nil {
+		t.Fatalf("Failed to create wrapper: %v", err)
+	}
+
+	// Record tool metrics with a Prometheus reader instead of the global providers
+	reader, metricsHandler, err := telemetry.NewPrometheusReader()
+	if err != nil {
+		t.Fatalf("Failed to create Prometheus reader: %v", err)
+	}
+	m.telemetry, err = telemetry.New(tracenoop.NewTracerProvider(), sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	if err != nil {
+		t.Fatalf("Failed to create telemetry: %v", err)
+	}
+
+	mcpServer, err := m.NewMCPServer()
+	if err != nil {
+		t.Fatalf("Failed to create MCP server: %v", err)
+	}
+	ts := httptest.NewServer(m.HTTPHandler(mcpServer, metricsHandler))
+	defer ts.Close()
+
+	get := func(t *testing.T, path string) (int, string) {
+		t.Helper()
+		resp, err := http.Get(ts.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s failed: %v", path, err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", path, err)
+		}
+		return resp.StatusCode, string(body)
+	}
+
+	t.Run("healthz", func(t *testing.T) {
+		status, body := get(t, "/healthz")
+		if status != http.StatusOK || !strings.Contains(body, `"status":"ok"`) {
+			t.Errorf("unexpected /healthz response %d %s", status, body)
+		}
+	})
+
+	t.Run("readyz", func(t *testing.T) {
+		status, body := get(t, "/readyz")
+		if status != http.StatusOK {
+			t.Fatalf("expected 200, got %d %s", status, body)
+		}
+
+		var ready readiness
+		if err := json.Unmarshal([]byte(body), &ready); err != nil {
+			t.Fatalf("invalid /readyz body %q: %v", body, err)
+		}
+		if ready.Status != "ready" || ready.OldestVersion != "1.13" || ready.Versions != len(m.supportedVersions()) {
+			t.Errorf("unexpected readiness %+v", ready)
+		}
+	})
+
+	t.Run("tool calls over HTTP are counted per version", func(t *testing.T) {
+		cli, err := client.NewStreamableHttpClient(ts.URL + mcpEndpoint)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP client: %v", err)
+		}
+		defer cli.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := cli.Start(ctx); err != nil {
+			t.Fatalf("Failed to start client: %v", err)
+		}
+		if _, err := cli.Initialize(ctx, mcp.InitializeRequest{
+			Params: mcp.InitializeParams{
+				ProtocolVersion: "2024-11-05",
+				ClientInfo:      mcp.Implementation{Name: "test-client", Version: "0.1.0"},
+			},
+		}); err != nil {
+			t.Fatalf("Failed to initialize client: %v", err)
+		}
+
+		for range 2 {
+			result, err := cli.CallTool(ctx, mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Name: "go-updates", Arguments: map[string]any{"version": "1.21"}},
+			})
+			if err != nil || result.IsError {
+				t.Fatalf("go-updates failed: %v %+v", err, result)
+			}
+		}
+
+		status, body := get(t, "/metrics")
+		if status != http.StatusOK {
+			t.Fatalf("expected 200 from /metrics, got %d", status)
+		}
+		for _, want := range []string{
+			`recent_go_mcp_version_requests_total{go_version="1.21",otel_scope_name="github.com/tenkoh/recent-go-mcp",otel_scope_version=""} 2`,
+			`recent_go_mcp_tool_calls_total{go_version="1.21",mcp_tool_name="go-updates"`,
+		} {
+			if !strings.Contains(body, want) {
+				t.Errorf("expected /metrics to contain %s, got:\n%s", want, body)
+			}
+		}
+	})
+}
+
+func TestHTTPHandler_WithoutMetrics(t *testing.T) {
+	m, err := New(config.Default())
+	if err != nil {
+		t.Fatalf("Failed to create wrapper: %v", err)
+	}
+	mcpServer, err := m.NewMCPServer()
+	if err != nil {
+		t.Fatalf("Failed to create MCP server: %v", err)
+	}
+
+	// A nil metrics handler must not panic; /metrics is simply not served
+	ts := httptest.NewServer(m.HTTPHandler(mcpServer, nil))
+	defer ts.Close()
+
+	for path, want := range map[string]int{"/metrics": http.StatusNotFound, "/healthz": http.StatusOK} {
+		resp, err := http.Get(ts.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s failed: %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Errorf("GET %s: expected %d, got %d", path, want, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,820 @@
+package mcpserver
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/tenkoh/recent-go-mcp/data"
+	"github.com/tenkoh/recent-go-mcp/internal/cache"
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/service"
+	"github.com/tenkoh/recent-go-mcp/internal/storage"
+	"github.com/tenkoh/recent-go-mcp/internal/telemetry"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+// Version of the MCP server
+const Version = "0.2.0"
+
+// Server wraps the dependencies shared by the MCP tools and the CLI
+type Server struct {
+	repository         domain.ReloadableRepository
+	featureService     domain.FeatureService
+	migrationService   domain.MigrationService
+	godebugService     domain.GodebugService
+	deprecationService domain.DeprecationService
+	platformService    domain.PlatformService
+	toolchainService   domain.ToolchainService
+	comparisonService  domain.ComparisonService
+	historyService     domain.PackageHistoryService
+	versionService     domain.VersionService
+	formatters         map[formatterKey]domain.ResponseFormatter
+	formats            *service.FormatterRegistry
+	listings           *service.FeatureListingCache
+	telemetry          *telemetry.Telemetry
+	logger             *slog.Logger
+	config             config.Config
+}
+
+// formatterKey selects one of the report formatters
+type formatterKey struct {
+	locale string
+	detail string
+}
+
+// Option configures New and NewMCPServer
+type Option func(*serverOptions)
+
+type serverOptions struct {
+	logger    *slog.Logger
+	releaseFS storage.FullFS
+}
+
+// WithLogger sets the logger of the server and its services; slog.Default() is used otherwise
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *serverOptions) {
+		o.logger = logger
+	}
+}
+
+// WithReleaseFS replaces the embedded release data, e.g. with fixtures in tests
+// The filesystem must use the layout of the data directory (releases and locales)
+func WithReleaseFS(releaseFS storage.FullFS) Option {
+	return func(o *serverOptions) {
+		o.releaseFS = releaseFS
+	}
+}
+
+// NewMCPServer creates a new MCP server with dependencies initialized and tools registered
+// Start from config.Default() and change the settings that matter; options inject dependencies
+func NewMCPServer(cfg config.Config, opts ...Option) (*server.MCPServer, error) {
+	mcpWrapper, err := New(cfg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return mcpWrapper.NewMCPServer()
+}
+
+// NewMCPServer creates an MCP server named after this project and registers every tool on it
+func (m *Server) NewMCPServer() (*server.MCPServer, error) {
+	s := server.NewMCPServer("recent-go-mcp", Version,
+		server.WithToolCapabilities(false))
+	if err := m.Register(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Register adds every enabled tool backed by the wrapped services to s
+func (m *Server) Register(s *server.MCPServer) error {
+	// The supported range is derived from the embedded data so tool descriptions never go stale
+	catalog, err := m.versionService.ListVersions(context.Background(), false)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("Loaded Go release data",
+		"supportedGoVersions", catalog.OldestVersion+"-"+catalog.LatestVersion,
+		"releases", len(catalog.Releases))
+
+	// Arguments shared by several tools; enums of versions and packages come from the repository
+	versionArg := func(name string, required bool, description string) toolArg {
+		return toolArg{
+			name:        name,
+			required:    required,
+			description: description,
+			allowed:     m.supportedVersions,
+			normalize:   service.NormalizeVersionArg,
+		}
+	}
+	packageArg := func(required bool, description string) toolArg {
+		return toolArg{
+			name:        "package",
+			required:    required,
+			description: description,
+			allowed:     m.allowedPackages,
+			normalize:   service.NormalizePackageArg,
+		}
+	}
+	// Every tool listing changes hides experimental ones unless asked, so counts and listings agree across tools
+	experimentalArg := toolArg{
+		name:        "include_experimental",
+		kind:        boolArg,
+		description: "Optional: include experimental changes (e.g., GOEXPERIMENT previews), which are hidden by default and labelled when included",
+	}
+	localeArg := toolArg{
+		name:        "locale",
+		description: "Optional: language of the response (default '" + m.config.Locale + "'); untranslated entries fall back to English",
+		allowed:     service.Locales,
+	}
+
+	// Define the go-updates tool
+	m.addTool(s, "go-updates",
+		"Get comprehensive Go language features and best practices for your project version in structured Markdown format. Supports Go "+catalog.OldestVersion+"-"+catalog.LatestVersion+", displaying all available features chronologically to help LLM coding agents use modern Go patterns and standard library functions efficiently.",
+		[]toolArg{
+			versionArg("version", true, "Go version your project is currently using (supported: '"+catalog.OldestVersion+"' through '"+catalog.LatestVersion+"', e.g., '1.21' or '"+catalog.LatestVersion+"')"),
+			packageArg(false, "Optional: filter features for a specific standard library package (e.g., 'net/http', 'context', 'slices', 'maps')"),
+			{name: "include_upcoming", kind: boolArg, description: "Optional: also list features of releases after your version, grouped per release in a separate 'Available If You Upgrade' section (these are NOT usable without upgrading)"},
+			{name: "include_experimental", kind: boolArg, description: "Optional: include experimental features (e.g., GOEXPERIMENT previews and experimental ports), which are hidden by default and labelled when included"},
+			{name: "detail", description: "Optional: 'full' adds code examples and source links, 'brief' lists one line per change (default '" + m.config.DetailLevel + "')", allowed: domain.DetailLevels},
+			{name: "format", description: "Optional: output format (default 'markdown'); 'llm' lists one terse line per change, 'cheatsheet' tables older patterns next to their modern replacements, 'json' returns the raw data, 'text' and 'html' suit terminals and web views", allowed: m.formats.Names},
+			localeArg,
+		},
+		m.handleGoUpdates)
+
+	// Define the go-migration-guide tool
+	m.addTool(s, "go-migration-guide",
+		"Get a step-by-step migration guide listing every breaking change and deprecation (language semantics, GODEBUG behavior changes, removed platforms, deprecated APIs) to address when upgrading a project between two Go versions.",
+		[]toolArg{
+			versionArg("from_version", true, "Go version your project is currently using (e.g., '1.21')"),
+			versionArg("to_version", false, "Optional: Go version you are upgrading to (e.g., '1.23'); defaults to the latest supported version"),
+			experimentalArg,
+			localeArg,
+		},
+		m.handleMigrationGuide)
+
+	// Define the go-godebug tool
+	m.addTool(s, "go-godebug",
+		"List the effective GODEBUG defaults for a go directive version (the 'go' line in go.mod) and which defaults changed relative to another version. Use it to understand behavior changes when raising the go directive.",
+		[]toolArg{
+			versionArg("version", true, "Go version declared by the go directive in go.mod (e.g., '1.21')"),
+			versionArg("compare_to", false, "Optional: another go directive version to compare defaults against (e.g., '1.23')"),
+			localeArg,
+		},
+		m.handleGodebug)
+
+	// Define the go-deprecations tool
+	m.addTool(s, "go-deprecations",
+		"List deprecated standard library APIs and their recommended replacements (e.g., io/ioutil.ReadAll -> io.ReadAll), either for everything deprecated up to a Go version or for a single symbol.",
+		[]toolArg{
+			versionArg("version", false, "Go version your project is using (e.g., '1.21'); lists every API deprecated in or before it"),
+			{name: "symbol", description: "Optional: look up a single symbol instead (e.g., 'io/ioutil.ReadAll', 'ioutil.ReadAll', 'math/rand.Seed')"},
+			experimentalArg,
+			localeArg,
+		},
+		m.handleDeprecations)
+
+	// Define the go-platforms tool
+	m.addTool(s, "go-platforms",
+		"Check port and operating system support for a Go version: whether a GOOS/GOARCH pair is supported, experimental or removed (e.g., 'is linux/loong64 supported in Go 1.20?') and the minimum OS version required (e.g., 'what is the minimum macOS for Go 1.23?').",
+		[]toolArg{
+			versionArg("version", true, "Go version to check (e.g., '1.23')"),
+			{name: "goos", description: "Optional: target operating system (e.g., 'linux', 'darwin', 'windows', 'wasip1')"},
+			{name: "goarch", description: "Optional: target architecture, requires goos (e.g., 'amd64', 'arm64', 'loong64')"},
+			localeArg,
+		},
+		m.handlePlatforms)
+
+	// Define the go-toolchain-updates tool
+	m.addTool(s, "go-toolchain-updates",
+		"List go command changes (go test, go vet, go mod, go work, go build, ...), flags, go.mod directives and environment variables available in a Go version. Use it when writing Makefiles and CI scripts to know which flags your Go version supports.",
+		[]toolArg{
+			versionArg("version", true, "Go version your project or CI uses (e.g., '1.21')"),
+			{name: "command", description: "Optional: restrict to one command and its subcommands (e.g., 'go test', 'vet', 'go mod', 'go.mod'); use 'go' for global flags and environment variables"},
+			localeArg,
+		},
+		m.handleToolchainUpdates)
+
+	// Define the go-compare-versions tool
+	m.addTool(s, "go-compare-versions",
+		"Compare two Go versions side by side: new APIs, language changes and breaking changes available in each, plus a table of the standard library packages changed between them. Use it to judge what an upgrade brings.",
+		[]toolArg{
+			versionArg("from_version", true, "One Go version to compare (e.g., '1.21')"),
+			versionArg("to_version", true, "The other Go version to compare (e.g., '"+catalog.LatestVersion+"'); versions may be given in any order"),
+			experimentalArg,
+			localeArg,
+		},
+		m.handleCompareVersions)
+
+	// Define the go-package-history tool
+	m.addTool(s, "go-package-history",
+		"Show every Go release that changed a standard library package in chronological order, including releases newer than your project version marked as 'not yet available'. Use it to tell users which upgrade brings an API they need.",
+		[]toolArg{
+			packageArg(true, "Standard library import path (e.g., 'slices', 'net/http', 'log/slog')"),
+			versionArg("version", false, "Optional: Go version your project is using (e.g., '1.21'); later releases are marked as not yet available"),
+			experimentalArg,
+			localeArg,
+		},
+		m.handlePackageHistory)
+
+	// Define the go-versions tool
+	m.addTool(s, "go-versions",
+		"List every Go version this server has data for, with release dates, summaries and per-release counts of language changes, breaking changes and new APIs. Call it first to discover which versions the other tools accept.",
+		[]toolArg{experimentalArg, localeArg},
+		m.handleVersions)
+
+	return nil
+}
+
+// ToolNames returns the name of every tool the server provides, in registration order
+func ToolNames() []string {
+	return []string{
+		"go-updates",
+		"go-migration-guide",
+		"go-godebug",
+		"go-deprecations",
+		"go-platforms",
+		"go-toolchain-updates",
+		"go-compare-versions",
+		"go-package-history",
+		"go-versions",
+	}
+}
+
+// validateSettings checks the settings config.Validate leaves to the server: the locale, which needs the
+// formatter's message catalogs, and the enabled tools, which are rejected before any tool is registered
+func validateSettings(cfg config.Config) error {
+	var errs []error
+	if !service.IsSupportedLocale(cfg.Locale) {
+		errs = append(errs, fmt.Errorf("unsupported locale %q (use %s)", cfg.Locale, strings.Join(service.Locales(), ", ")))
+	}
+	names := ToolNames()
+	for _, name := range cfg.EnabledTools {
+		if !slices.Contains(names, name) {
+			errs = append(errs, fmt.Errorf("unknown tool %q in enabled tools (use %s)", name, strings.Join(names, ", ")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// New initializes the repository and services shared by the MCP tools and the CLI
+func New(cfg config.Config, opts ...Option) (*Server, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateSettings(cfg); err != nil {
+		return nil, err
+	}
+
+	options := serverOptions{
+		logger:    slog.Default(),
+		releaseFS: data.FS,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	comparator := version.NewSemanticVersionComparator()
+
+	// Instruments come from the global providers, which are no-ops unless telemetry.Setup installed exporters
+	tel, err := telemetry.NewFromGlobal(telemetry.WithLogger(options.logger))
+	if err != nil {
+		return nil, err
+	}
+
+	// Release data on disk replaces the embedded copy so it can be updated without rebuilding
+	releaseFS := options.releaseFS
+	if cfg.Data.ReleasesDir != "" || cfg.Data.LocalesDir != "" {
+		releaseFS = storage.NewDataDirFS(releaseFS, cfg.Data.ReleasesDir, cfg.Data.LocalesDir)
+	}
+
+	// Production deployments can hide unverified entries from every tool; otherwise they are labelled in the output
+	var repoOpts []storage.RepositoryOption
+	if cfg.VerifiedOnly {
+		repoOpts = append(repoOpts, storage.WithVerifiedOnly())
+	}
+	embedded, err := storage.NewEmbeddedReleaseRepository(releaseFS, comparator, repoOpts...)
+	if err != nil {
+		return nil, err
+	}
+	themes, err := loadCheatsheetThemes(cfg.Data.CheatsheetFile, releaseFS)
+	if err != nil {
+		return nil, err
+	}
+	repo := telemetry.NewTracedRepository(embedded, tel)
+
+	// Cache responses and formatted output; the data only changes on reload
+	// The tracing decorator sits outside the cache so cache hits are measured too
+	cachedFeatureService := service.NewCachedFeatureService(service.NewFeatureService(repo, comparator), cfg.CacheSize)
+	featureService := telemetry.NewTracedFeatureService(cachedFeatureService, tel)
+	formats := service.NewFormatterRegistry(comparator,
+		service.WithFormatterOptions(service.WithUnverifiedBadges()),
+		service.WithCheatsheetThemes(themes))
+	listings := service.NewFeatureListingCache(cfg.CacheSize)
+	formatters := make(map[formatterKey]domain.ResponseFormatter)
+	for _, locale := range service.Locales() {
+		for _, detail := range domain.DetailLevels() {
+			formatters[formatterKey{locale: locale, detail: detail}] = service.NewResponseFormatter(comparator,
+				service.WithUnverifiedBadges(), service.WithLocale(locale), service.WithDetailLevel(detail))
+		}
+	}
+	repo.OnReload(func() {
+		cachedFeatureService.Invalidate()
+		listings.Invalidate()
+	})
+	if err := tel.ObserveCaches(map[string]func() cache.Stats{
+		"features": cachedFeatureService.Stats,
+		"listings": listings.Stats,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Create the wrapper for dependency injection
+	return &Server{
+		repository:         repo,
+		featureService:     featureService,
+		migrationService:   service.NewMigrationService(repo, comparator),
+		godebugService:     service.NewGodebugService(repo, comparator),
+		deprecationService: service.NewDeprecationService(repo, comparator),
+		platformService:    service.NewPlatformService(repo),
+		toolchainService:   service.NewToolchainService(repo),
+		comparisonService:  service.NewComparisonService(featureService, comparator),
+		historyService:     service.NewPackageHistoryService(repo, comparator),
+		versionService:     service.NewVersionService(repo),
+		formatters:         formatters,
+		formats:            formats,
+		listings:           listings,
+		telemetry:          tel,
+		logger:             options.logger,
+		config:             cfg,
+	}, nil
+}
+
+// loadCheatsheetThemes reads the configured theme mapping, or the one next to the release data
+// Release data without a mapping (e.g., an embedder's fixture) falls back to the embedded themes
+func loadCheatsheetThemes(file string, releaseFS fs.FS) ([]domain.CheatsheetTheme, error) {
+	if file != "" {
+		return storage.LoadCheatsheetThemes(os.DirFS(filepath.Dir(file)), filepath.Base(file))
+	}
+	themes, err := storage.LoadCheatsheetThemes(releaseFS, storage.CheatsheetFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return storage.LoadCheatsheetThemes(data.FS, storage.CheatsheetFile)
+	}
+	return themes, err
+}
+
+// supportedVersions returns every available version, oldest first
+// It returns nil when the data cannot be read, which disables version validation rather than rejecting every call
+func (m *Server) supportedVersions() []string {
+	releases, err := m.repository.GetAllReleases(context.Background())
+	if err != nil {
+		return nil
+	}
+	versions := make([]string, 0, len(releases))
+	for _, release := range slices.Backward(releases) {
+		versions = append(versions, release.Version)
+	}
+	return versions
+}
+
+// allowedPackages returns the values accepted by package arguments: the configured allowlist, or every known package
+// The allowlist only restricts tool arguments; the CLI and the goreleases package query every package
+func (m *Server) allowedPackages() []string {
+	if len(m.config.PackageAllowlist) > 0 {
+		return slices.Sorted(slices.Values(m.config.PackageAllowlist))
+	}
+	return m.knownPackages()
+}
+
+// knownPackages returns the sorted import paths changed in any available release
+func (m *Server) knownPackages() []string {
+	ctx := context.Background()
+	latest, err := m.repository.GetLatestVersion(ctx)
+	if err != nil {
+		return nil
+	}
+	packages, err := m.repository.GetPackagesUpToVersion(ctx, latest)
+	if err != nil {
+		return nil
+	}
+	return packages
+}
+
+// Config returns the settings the server was created with
+func (m *Server) Config() config.Config {
+	return m.config
+}
+
+// Repository returns the release repository shared by every service
+func (m *Server) Repository() domain.ReleaseRepository {
+	return m.repository
+}
+
+// Reload re-reads the release data and drops the cached responses and listings
+// The previously loaded data is kept if loading fails
+func (m *Server) Reload(ctx context.Context) error {
+	return m.repository.Reload(ctx)
+}
+
+// FeatureService returns the cached feature service behind go-updates
+func (m *Server) FeatureService() domain.FeatureService {
+	return m.featureService
+}
+
+// ComparisonService returns the service behind go-compare-versions
+func (m *Server) ComparisonService() domain.ComparisonService {
+	return m.comparisonService
+}
+
+// VersionService returns the service behind go-versions
+func (m *Server) VersionService() domain.VersionService {
+	return m.versionService
+}
+
+// Formats returns the registry of feature listing formats
+// Add formats through RegisterFormat so that go-updates drops listings cached in a replaced format
+func (m *Server) Formats() *service.FormatterRegistry {
+	return m.formats
+}
+
+// RegisterFormat adds a format selectable by go-updates or replaces a built-in one
+func (m *Server) RegisterFormat(name string, formatter domain.FeatureFormatter) {
+	m.formats.Register(name, formatter)
+	m.listings.Invalidate()
+}
+
+// FormatOptions returns the options of a feature listing for the locale and detail arguments
+// Omitted or unknown values fall back to the configured defaults; full listings include code examples
+func (m *Server) FormatOptions(version, packageName, locale, detail string) domain.FormatOptions {
+	if !service.IsSupportedLocale(locale) {
+		locale = m.config.Locale
+	}
+	if !domain.IsSupportedDetailLevel(detail) {
+		detail = m.config.DetailLevel
+	}
+	return domain.FormatOptions{
+		Version:         version,
+		Package:         packageName,
+		Locale:          locale,
+		DetailLevel:     detail,
+		IncludeExamples: detail == domain.DetailFull,
+	}
+}
+
+// Formatter returns the formatter for the locale and detail arguments
+// Omitted or unknown values fall back to the configured defaults
+func (m *Server) Formatter(locale, detail string) domain.ResponseFormatter {
+	if !service.IsSupportedLocale(locale) {
+		locale = m.config.Locale
+	}
+	if !domain.IsSupportedDetailLevel(detail) {
+		detail = m.config.DetailLevel
+	}
+	return m.formatters[formatterKey{locale: locale, detail: detail}]
+}
+
+func (m *Server) handleGoUpdates(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-updates request", "args", args)
+
+	version := args.String("version")
+	packageName := args.String("package")
+	includeUpcoming := args.Bool("include_upcoming")
+	includeExperimental := args.Bool("include_experimental")
+
+	logger.InfoContext(ctx, "Processing feature request",
+		"version", version,
+		"package", packageName,
+		"hasPackageFilter", packageName != "",
+		"includeUpcoming", includeUpcoming,
+		"includeExperimental", includeExperimental)
+
+	// Render the listing in the requested format, Markdown unless the caller asks otherwise
+	// Listings are cached on the normalized query, so repeated calls skip both the lookup and the formatting
+	query := domain.FeatureQuery{
+		Version:             version,
+		Package:             packageName,
+		IncludeUpcoming:     includeUpcoming,
+		IncludeExperimental: includeExperimental,
+	}
+	format := cmp.Or(args.String("format"), service.FormatMarkdown)
+	opts := m.FormatOptions(version, packageName, args.String("locale"), args.String("detail"))
+	formatted, err := m.listings.Listing(format, query, opts, func() (string, error) {
+		response, err := m.featureService.QueryFeatures(ctx, query)
+		if err != nil {
+			return "", err
+		}
+
+		logger.DebugContext(ctx, "Features retrieved successfully",
+			"changesCount", len(response.Changes),
+			"packagesCount", len(response.PackageInfo))
+
+		return m.formats.Format(format, response, opts)
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get features",
+			"error", err,
+			"version", version,
+			"package", packageName,
+			"format", format)
+		return m.errorResult(ctx, err), nil
+	}
+
+	logger.InfoContext(ctx, "Request processed successfully",
+		"version", version,
+		"package", packageName,
+		"format", format,
+		"responseLength", len(formatted))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(formatted),
+		},
+	}, nil
+}
+
+func (m *Server) handleMigrationGuide(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-migration-guide request", "args", args)
+
+	fromVersion := args.String("from_version")
+	toVersion := args.String("to_version")
+
+	guide, err := m.migrationService.GetMigrationGuide(ctx, fromVersion, toVersion, args.Bool("include_experimental"))
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get migration guide",
+			"error", err,
+			"fromVersion", fromVersion,
+			"toVersion", toVersion)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatMigrationGuide(guide)
+
+	logger.InfoContext(ctx, "Migration guide processed successfully",
+		"fromVersion", guide.FromVersion,
+		"toVersion", guide.ToVersion,
+		"steps", len(guide.Steps),
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+func (m *Server) handleGodebug(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-godebug request", "args", args)
+
+	version := args.String("version")
+	compareTo := args.String("compare_to")
+
+	report, err := m.godebugService.GetGodebugReport(ctx, version, compareTo)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get GODEBUG report",
+			"error", err,
+			"version", version,
+			"compareTo", compareTo)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatGodebugReport(report)
+
+	logger.InfoContext(ctx, "GODEBUG request processed successfully",
+		"version", version,
+		"compareTo", compareTo,
+		"settings", len(report.Settings),
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+func (m *Server) handleDeprecations(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-deprecations request", "args", args)
+
+	version := args.String("version")
+	symbol := args.String("symbol")
+
+	if version == "" && symbol == "" {
+		logger.WarnContext(ctx, "Missing version and symbol arguments")
+		return m.errorResult(ctx, domain.NewValidationError("go-deprecations", "either version or symbol argument is required", nil)), nil
+	}
+
+	var report *domain.DeprecationReport
+	var err error
+	if symbol != "" {
+		report, err = m.deprecationService.LookupDeprecation(ctx, symbol, args.Bool("include_experimental"))
+	} else {
+		report, err = m.deprecationService.ListDeprecations(ctx, version, args.Bool("include_experimental"))
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get deprecations",
+			"error", err,
+			"version", version,
+			"symbol", symbol)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatDeprecationReport(report)
+
+	logger.InfoContext(ctx, "Deprecations request processed successfully",
+		"version", version,
+		"symbol", symbol,
+		"deprecations", len(report.Deprecations),
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+func (m *Server) handlePlatforms(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-platforms request", "args", args)
+
+	version := args.String("version")
+	goos := args.String("goos")
+	goarch := args.String("goarch")
+
+	report, err := m.platformService.GetPlatformSupport(ctx, version, goos, goarch)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get platform support",
+			"error", err,
+			"version", version,
+			"goos", goos,
+			"goarch", goarch)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatPlatformReport(report)
+
+	logger.InfoContext(ctx, "Platform request processed successfully",
+		"version", version,
+		"goos", goos,
+		"goarch", goarch,
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+func (m *Server) handleToolchainUpdates(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-toolchain-updates request", "args", args)
+
+	version := args.String("version")
+	command := args.String("command")
+
+	report, err := m.toolchainService.GetToolchainUpdates(ctx, version, command)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get toolchain updates",
+			"error", err,
+			"version", version,
+			"command", command)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatToolchainReport(report)
+
+	logger.InfoContext(ctx, "Toolchain request processed successfully",
+		"version", version,
+		"command", command,
+		"entries", len(report.Entries),
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+func (m *Server) handleCompareVersions(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-compare-versions request", "args", args)
+
+	fromVersion := args.String("from_version")
+	toVersion := args.String("to_version")
+
+	comparison, err := m.comparisonService.CompareVersions(ctx, fromVersion, toVersion, args.Bool("include_experimental"))
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to compare versions",
+			"error", err,
+			"fromVersion", fromVersion,
+			"toVersion", toVersion)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatVersionComparison(comparison)
+
+	logger.InfoContext(ctx, "Version comparison processed successfully",
+		"fromVersion", comparison.FromVersion,
+		"toVersion", comparison.ToVersion,
+		"packages", len(comparison.Packages),
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+func (m *Server) handlePackageHistory(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-package-history request", "args", args)
+
+	packageName := args.String("package")
+	version := args.String("version")
+
+	history, err := m.historyService.GetPackageHistory(ctx, domain.PackageHistoryQuery{
+		Package:             packageName,
+		Version:             version,
+		IncludeExperimental: args.Bool("include_experimental"),
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get package history",
+			"error", err,
+			"package", packageName,
+			"version", version)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatPackageHistory(history)
+
+	logger.InfoContext(ctx, "Package history processed successfully",
+		"package", history.Package,
+		"version", version,
+		"releases", len(history.Entries),
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+func (m *Server) handleVersions(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error) {
+	logger := m.logger
+
+	logger.DebugContext(ctx, "Processing go-versions request", "args", args)
+
+	catalog, err := m.versionService.ListVersions(ctx, args.Bool("include_experimental"))
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list versions", "error", err)
+		return m.errorResult(ctx, err), nil
+	}
+
+	markdownResponse := m.Formatter(args.String("locale"), "").FormatVersionCatalog(catalog)
+
+	logger.InfoContext(ctx, "Version list processed successfully",
+		"releases", len(catalog.Releases),
+		"responseLength", len(markdownResponse))
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(markdownResponse),
+		},
+	}, nil
+}
+
+// typeof returns the type name of a value for logging
+func typeof(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	return reflect.TypeOf(v).String()
+}
@@ -0,0 +1,384 @@
+package mcpserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+)
+
+func TestMCPServer_GoUpdates(t *testing.T) {
+	// Create MCP server with dependencies and tools registered
+	mcpServer, err := NewMCPServer(config.Default())
+	if err != nil {
+		t.Fatalf("Failed to create MCP server: %v", err)
+	}
+
+	// Create test server using the MCP library's testing utilities
+	testServer := server.NewTestServer(mcpServer)
+	defer testServer.Close()
+
+	// Test that the server was created successfully and tools are registered
+	t.Run("test server creation and tool registration", func(t *testing.T) {
+		if testServer == nil {
+			t.Fatal("Expected test server to be created")
+		}
+		// The fact that NewTestServer succeeded means tools are registered correctly
+	})
+
+	// Test actual request to the server
+	t.Run("test go-updates tool request", func(t *testing.T) {
+		// Create in-process client for more reliable testing
+		cli, err := client.NewInProcessClient(mcpServer)
+		if err != nil {
+			t.Fatalf("Failed to create in-process client: %v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		// Start the client transport
+		if err := cli.Start(ctx); err != nil {
+			t.Fatalf("Failed to start client: %v", err)
+		}
+
+		// Initialize client with proper request
+		initReq := mcp.InitializeRequest{
+			Params: mcp.InitializeParams{
+				ProtocolVersion: "2024-11-05",
+				ClientInfo: mcp.Implementation{
+					Name:    "test-client",
+					Version: "0.1.0",
+				},
+				Capabilities: mcp.ClientCapabilities{},
+			},
+		}
+		_, err = cli.Initialize(ctx, initReq)
+		if err != nil {
+			t.Fatalf("Failed to initialize client: %v", err)
+		}
+		defer cli.Close()
+
+		// Call the go-updates tool with proper request
+		toolReq := mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Name: "go-updates",
+				Arguments: map[string]any{
+					"version": "1.22",
+				},
+			},
+		}
+
+		result, err := cli.CallTool(ctx, toolReq)
+		if err != nil {
+			t.Fatalf("Failed to call go-updates tool: %v", err)
+		}
+
+		if result == nil {
+			t.Fatal("Expected result, got nil")
+		}
+
+		if len(result.Content) == 0 {
+			t.Fatal("Expected content in result")
+		}
+
+		// Verify we got some content (simplified check)
+		if len(result.Content) < 1 {
+			t.Fatal("Expected at least one content item")
+		}
+	})
+}
+
+func TestNewMCPServer_Config(t *testing.T) {
+	connect := func(t *testing.T, mcpServer *server.MCPServer) (*client.Client, context.Context) {
+		t.Helper()
+		cli, err := client.NewInProcessClient(mcpServer)
+		if err != nil {
+			t.Fatalf("Failed to create in-process client: %v", err)
+		}
+		t.Cleanup(func() { cli.Close() })
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		t.Cleanup(cancel)
+		if err := cli.Start(ctx); err != nil {
+			t.Fatalf("Failed to start client: %v", err)
+		}
+		if _, err := cli.Initialize(ctx, mcp.InitializeRequest{
+			Params: mcp.InitializeParams{
+				ProtocolVersion: "2024-11-05",
+				ClientInfo:      mcp.Implementation{Name: "test-client", Version: "0.1.0"},
+			},
+		}); err != nil {
+			t.Fatalf("Failed to initialize client: %v", err)
+		}
+		return cli, ctx
+	}
+	newClient := func(t *testing.T, cfg config.Config, opts ...Option) (*client.Client, context.Context) {
+		t.Helper()
+		mcpServer, err := NewMCPServer(cfg, opts...)
+		if err != nil {
+			t.Fatalf("Failed to create MCP server: %v", err)
+		}
+		return connect(t, mcpServer)
+	}
+	callText := func(t *testing.T, cli *client.Client, ctx context.Context, name string, args map[string]any) (string, bool) {
+		t.Helper()
+		result, err := cli.CallTool(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: name, Arguments: args}})
+		if err != nil {
+			t.Fatalf("Failed to call %s: %v", name, err)
+		}
+		return result.Content[0].(mcp.TextContent).Text, result.IsError
+	}
+
+	t.Run("enabled tools and package allowlist", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.EnabledTools = []string{"go-updates", "go-versions"}
+		cfg.PackageAllowlist = []string{"slices", "net/http"}
+		cli, ctx := newClient(t, cfg)
+
+		tools, err := cli.ListTools(ctx, mcp.ListToolsRequest{})
+		if err != nil {
+			t.Fatalf("Failed to list tools: %v", err)
+		}
+		var names []string
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		slices.Sort(names)
+		if !slices.Equal(names, []string{"go-updates", "go-versions"}) {
+			t.Errorf("expected only the enabled tools, got %v", names)
+		}
+
+		// Every registered tool can be enabled by name
+		all, allCtx := newClient(t, config.Default())
+		tools, err = all.ListTools(allCtx, mcp.ListToolsRequest{})
+		if err != nil {
+			t.Fatalf("Failed to list tools: %v", err)
+		}
+		names = nil
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		slices.Sort(names)
+		if !slices.Equal(names, slices.Sorted(slices.Values(ToolNames()))) {
+			t.Errorf("expected ToolNames to match the registered tools, got %v", names)
+		}
+
+		if text, isError := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.22", "package": "maps"}); !isError || !strings.Contains(text, "allowed values: net/http, slices") {
+			t.Errorf("expected packages outside the allowlist to be rejected, got %q", text)
+		}
+	})
+
+	t.Run("default locale and detail level", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.Locale = "ja"
+		cfg.DetailLevel = "brief"
+		cli, ctx := newClient(t, cfg)
+
+		text, _ := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.22", "package": "net/http"})
+		if !strings.Contains(text, "## 概要") || strings.Contains(text, "```go") {
+			t.Errorf("expected a brief Japanese response, got:\n%s", text)
+		}
+
+		// Arguments override the defaults per call
+		text, _ = callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.22", "package": "net/http", "locale": "en", "detail": "full"})
+		if !strings.Contains(text, "## Summary") || !strings.Contains(text, "```go") {
+			t.Errorf("expected a full English response, got:\n%s", text)
+		}
+
+		// Summaries built by the services are translated too
+		summaries := []struct {
+			tool     string
+			args     map[string]any
+			expected string
+		}{
+			{"go-updates", map[string]any{"version": "1.22", "package": "net/http"}, "Go 1.22 のプロジェクトでパッケージ 'net/http' に利用できる機能"},
+			{"go-migration-guide", map[string]any{"from_version": "1.21", "to_version": "1.22"}, "Go 1.21 から Go 1.22 へのアップグレードで確認が必要な"},
+			{"go-platforms", map[string]any{"version": "1.19", "goos": "wasip1", "goarch": "wasm"}, "wasip1/wasm は Go 1.21 で実験的なポートとして追加されるまでサポートされていません"},
+			{"go-toolchain-updates", map[string]any{"version": "1.22"}, "件 (対象: go コマンド)"},
+		}
+		for _, tt := range summaries {
+			if text, _ := callText(t, cli, ctx, tt.tool, tt.args); !strings.Contains(text, tt.expected) {
+				t.Errorf("expected a Japanese summary from %s, got:\n%s", tt.tool, text)
+			}
+		}
+	})
+
+	t.Run("format argument", func(t *testing.T) {
+		cli, ctx := newClient(t, config.Default())
+
+		text, isError := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.22", "package": "net/http", "format": "llm"})
+		if isError || !strings.HasPrefix(text, "Go Features Available (Go 1.22)\n") || strings.Contains(text, "##") {
+			t.Errorf("expected the llm format, got:\n%s", text)
+		}
+
+		text, isError = callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.22", "package": "net/http", "format": "cheatsheet"})
+		if isError || !strings.Contains(text, "## HTTP") || !strings.Contains(text, "| `userID := r.PathValue(\"id\")` | Go 1.22 |") {
+			t.Errorf("expected the cheatsheet format, got:\n%s", text)
+		}
+
+		if text, isError := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.22", "format": "yaml"}); !isError || !strings.Contains(text, "allowed values: cheatsheet, html, json, llm, markdown, text") {
+			t.Errorf("expected unknown formats to be rejected, got %q", text)
+		}
+	})
+
+	t.Run("release data from options", func(t *testing.T) {
+		releases := fstest.MapFS{
+			"releases/go1.30.json": &fstest.MapFile{Data: []byte(`{"version": "1.30", "summary": "Fixture release", "changes": [], "packages": {}}`)},
+		}
+		cli, ctx := newClient(t, config.Default(), WithReleaseFS(releases))
+
+		if text, _ := callText(t, cli, ctx, "go-versions", nil); !strings.Contains(text, "Fixture release") {
+			t.Errorf("expected the fixture release, got:\n%s", text)
+		}
+	})
+
+	t.Run("reload drops cached listings", func(t *testing.T) {
+		releases := fstest.MapFS{
+			"releases/go1.30.json": &fstest.MapFile{Data: []byte(`{"version": "1.30", "summary": "Fixture release", "changes": [{"category": "language", "description": "First draft", "impact": "new"}], "packages": {}}`)},
+		}
+		srv, err := New(config.Default(), WithReleaseFS(releases))
+		if err != nil {
+			t.Fatalf("Failed to create server: %v", err)
+		}
+		mcpServer, err := srv.NewMCPServer()
+		if err != nil {
+			t.Fatalf("Failed to create MCP server: %v", err)
+		}
+		cli, ctx := connect(t, mcpServer)
+
+		if text, _ := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.30"}); !strings.Contains(text, "First draft") {
+			t.Fatalf("expected the fixture change, got:\n%s", text)
+		}
+
+		releases["releases/go1.30.json"] = &fstest.MapFile{Data: []byte(`{"version": "1.30", "summary": "Fixture release", "changes": [{"category": "language", "description": "Corrected entry", "impact": "new"}], "packages": {}}`)}
+		if err := srv.Reload(ctx); err != nil {
+			t.Fatalf("Reload failed: %v", err)
+		}
+		if text, _ := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.30"}); !strings.Contains(text, "Corrected entry") {
+			t.Errorf("expected the reloaded change instead of the cached listing, got:\n%s", text)
+		}
+	})
+
+	t.Run("verified only applies to every tool", func(t *testing.T) {
+		releases := fstest.MapFS{
+			"releases/go1.21.json": &fstest.MapFile{Data: []byte(`{
+				"version": "1.21", "summary": "Older release",
+				"changes": [{"category": "language", "description": "Reviewed builtins", "impact": "new", "verified": true, "reviewed_by": "alice"}],
+				"packages": {"net/http": [{"function": "Handle", "description": "Reviewed handler", "impact": "new", "verified": true, "reviewed_by": "alice"}]}
+			}`)},
+			"releases/go1.22.json": &fstest.MapFile{Data: []byte(`{
+				"version": "1.22", "summary": "Newer release",
+				"changes": [
+					{"category": "language", "description": "Reviewed loop semantics", "impact": "breaking", "migration_notes": "Reviewed notes", "verified": true, "reviewed_by": "alice"},
+					{"category": "runtime", "description": "Unreviewed breaking change", "impact": "breaking", "migration_notes": "Unreviewed notes"}
+				],
+				"packages": {"net/http": [
+					{"function": "ServeMux", "description": "Reviewed routing", "impact": "enhancement", "verified": true, "reviewed_by": "alice"},
+					{"function": "UnreviewedFunc", "description": "Unreviewed API", "impact": "new"},
+					{"function": "UnreviewedOld", "description": "Unreviewed deprecated API", "impact": "deprecation"}
+				]},
+				"godebug": [{"name": "unreviewedsetting", "default": "1", "description": "Unreviewed setting"}],
+				"deprecations": [{"symbol": "io/ioutil.ReadAll", "replacement": "io.ReadAll", "description": "Unreviewed deprecation"}],
+				"platforms": [{"goos": "linux", "goarch": "unreviewed64", "status": "added", "description": "Unreviewed port"}],
+				"toolchain": [{"command": "go vet", "flag": "-unreviewed", "status": "added", "description": "Unreviewed flag"}]
+			}`)},
+		}
+		// marker is only rendered while the unverified entries are listed
+		calls := []struct {
+			tool   string
+			args   map[string]any
+			marker string
+		}{
+			{"go-updates", map[string]any{"version": "1.22"}, "Unreviewed"},
+			{"go-migration-guide", map[string]any{"from_version": "1.21", "to_version": "1.22"}, "Unreviewed"},
+			{"go-godebug", map[string]any{"version": "1.22"}, "unreviewedsetting"},
+			{"go-deprecations", map[string]any{"version": "1.22"}, "Unreviewed"},
+			{"go-platforms", map[string]any{"version": "1.22"}, "unreviewed64"},
+			{"go-toolchain-updates", map[string]any{"version": "1.22"}, "-unreviewed"},
+			{"go-compare-versions", map[string]any{"from_version": "1.21", "to_version": "1.22"}, "| Breaking changes | 0 | 2 |"},
+			{"go-package-history", map[string]any{"package": "net/http"}, "UnreviewedFunc"},
+		}
+
+		// Without verified-only mode every tool shows its unverified fixture entry
+		cli, ctx := newClient(t, config.Default(), WithReleaseFS(releases))
+		for _, call := range calls {
+			if text, _ := callText(t, cli, ctx, call.tool, call.args); !strings.Contains(text, call.marker) {
+				t.Errorf("%s: expected the unverified fixture entry by default, got:\n%s", call.tool, text)
+			}
+		}
+
+		cfg := config.Default()
+		cfg.VerifiedOnly = true
+		cli, ctx = newClient(t, cfg, WithReleaseFS(releases))
+		for _, call := range calls {
+			text, isError := callText(t, cli, ctx, call.tool, call.args)
+			if isError || strings.Contains(text, call.marker) {
+				t.Errorf("%s: expected unverified entries to be hidden, got:\n%s", call.tool, text)
+			}
+		}
+	})
+
+	t.Run("cheatsheet themes from a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "themes.json")
+		themes := `{"themes": [{"name": "sorting", "title": "Sorting", "rules": [{"package": "slices", "function": "Sort", "replaces": "sort.Ints"}]}]}`
+		if err := os.WriteFile(path, []byte(themes), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		cfg := config.Default()
+		cfg.Data.CheatsheetFile = path
+		cli, ctx := newClient(t, cfg)
+
+		text, _ := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.22", "format": "cheatsheet"})
+		if !strings.Contains(text, "## Sorting") || strings.Contains(text, "## Collections") {
+			t.Errorf("expected only the configured theme, got:\n%s", text)
+		}
+
+		// Release data without themes uses the embedded ones
+		releases := fstest.MapFS{
+			"releases/go1.30.json": &fstest.MapFile{Data: []byte(`{"version": "1.30", "summary": "Fixture release", "changes": [], "packages": {"errors": [{"function": "Join", "description": "joins errors", "impact": "new", "example": "errors.Join(a, b)"}]}}`)},
+		}
+		cli, ctx = newClient(t, config.Default(), WithReleaseFS(releases))
+		if text, _ := callText(t, cli, ctx, "go-updates", map[string]any{"version": "1.30", "format": "cheatsheet"}); !strings.Contains(text, "## Errors") {
+			t.Errorf("expected the embedded themes, got:\n%s", text)
+		}
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.EnabledTools = []string{"go-update"}
+		if _, err := NewMCPServer(cfg); err == nil || !strings.Contains(err.Error(), `unknown tool "go-update"`) {
+			t.Errorf("expected an unknown tool error, got %v", err)
+		}
+		if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), `unknown tool "go-update"`) {
+			t.Errorf("expected unknown tools to be rejected before registration, got %v", err)
+		}
+
+		cfg = config.Default()
+		cfg.Locale = "fr"
+		if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), `unsupported locale "fr"`) {
+			t.Errorf("expected an unsupported locale error, got %v", err)
+		}
+
+		cfg = config.Default()
+		cfg.CacheSize = -1
+		if _, err := NewMCPServer(cfg); err == nil {
+			t.Error("expected a validation error")
+		}
+
+		cfg = config.Default()
+		cfg.Data.CheatsheetFile = filepath.Join(t.TempDir(), "missing.json")
+		if _, err := NewMCPServer(cfg); err == nil || !strings.Contains(err.Error(), "cheat sheet themes") {
+			t.Errorf("expected a missing themes error, got %v", err)
+		}
+	})
+}
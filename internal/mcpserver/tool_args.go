@@ -0,0 +1,213 @@
+package mcpserver
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/logging"
+	"github.com/tenkoh/recent-go-mcp/internal/telemetry"
+)
+
+// argKind is the JSON type of a tool argument
+type argKind int
+
+const (
+	stringArg argKind = iota
+	boolArg
+)
+
+// toolArg describes one tool argument
+// The same description produces the input schema and validates incoming calls, so the two cannot drift apart
+type toolArg struct {
+	name        string
+	kind        argKind
+	required    bool
+	description string
+	// allowed returns the accepted values of a string argument; nil accepts any string
+	// It is consulted on every call so that reloaded release data is accepted without a restart
+	allowed func() []string
+	// normalize canonicalizes a string value before validation (e.g., "go1.22" -> "1.22")
+	normalize func(string) string
+}
+
+// schemaOption converts the argument into an mcp.NewTool option
+func (a toolArg) schemaOption() mcp.ToolOption {
+	var opts []mcp.PropertyOption
+	if a.required {
+		opts = append(opts, mcp.Required())
+	}
+	if a.allowed != nil {
+		if values := a.allowed(); len(values) > 0 {
+			opts = append(opts, mcp.Enum(values...))
+		}
+	}
+	opts = append(opts, mcp.Description(a.description))
+
+	if a.kind == boolArg {
+		return mcp.WithBoolean(a.name, opts...)
+	}
+	return mcp.WithString(a.name, opts...)
+}
+
+// toolArgs holds validated arguments; absent optional arguments read as zero values
+type toolArgs struct {
+	strings map[string]string
+	bools   map[string]bool
+}
+
+// String returns a validated string argument
+func (a toolArgs) String(name string) string {
+	return a.strings[name]
+}
+
+// Bool returns a validated boolean argument
+func (a toolArgs) Bool(name string) bool {
+	return a.bools[name]
+}
+
+// LogValue lets loggers print the validated arguments instead of the internal maps
+func (a toolArgs) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, len(a.strings)+len(a.bools))
+	for _, name := range slices.Sorted(maps.Keys(a.strings)) {
+		attrs = append(attrs, slog.String(name, a.strings[name]))
+	}
+	for _, name := range slices.Sorted(maps.Keys(a.bools)) {
+		attrs = append(attrs, slog.Bool(name, a.bools[name]))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// bindArgs validates raw call arguments against specs
+// Failures are validation errors carrying the argument name and, for enumerated arguments, the allowed values
+func bindArgs(tool string, specs []toolArg, raw map[string]any) (toolArgs, error) {
+	bound := toolArgs{
+		strings: make(map[string]string),
+		bools:   make(map[string]bool),
+	}
+
+	for _, spec := range specs {
+		value, exists := raw[spec.name]
+		if !exists || value == nil {
+			if spec.required {
+				return bound, domain.NewValidationError(tool, "argument '"+spec.name+"' is required", nil).
+					WithContext("argument", spec.name)
+			}
+			continue
+		}
+
+		switch spec.kind {
+		case boolArg:
+			b, ok := value.(bool)
+			if !ok {
+				return bound, domain.NewValidationError(tool, "argument '"+spec.name+"' must be a boolean", nil).
+					WithContext("argument", spec.name).
+					WithContext("type", typeof(value))
+			}
+			bound.bools[spec.name] = b
+
+		default:
+			s, ok := value.(string)
+			if !ok {
+				return bound, domain.NewValidationError(tool, "argument '"+spec.name+"' must be a string", nil).
+					WithContext("argument", spec.name).
+					WithContext("type", typeof(value))
+			}
+			if spec.normalize != nil {
+				s = spec.normalize(s)
+			}
+
+			// An empty optional string means the argument was left out
+			if s == "" {
+				if spec.required {
+					return bound, domain.NewValidationError(tool, "argument '"+spec.name+"' cannot be empty", nil).
+						WithContext("argument", spec.name)
+				}
+				continue
+			}
+
+			if spec.allowed != nil {
+				if allowed := spec.allowed(); len(allowed) > 0 && !slices.Contains(allowed, s) {
+					return bound, domain.NewValidationError(tool, "argument '"+spec.name+"' does not accept '"+s+"'", nil).
+						WithContext("argument", spec.name).
+						WithContext("value", s).
+						WithContext("allowed", allowed)
+				}
+			}
+			bound.strings[spec.name] = s
+		}
+	}
+
+	return bound, nil
+}
+
+// toolHandler handles a tool call whose arguments have been validated
+type toolHandler func(ctx context.Context, args toolArgs) (*mcp.CallToolResult, error)
+
+// addTool registers a tool whose schema and validation are both derived from specs
+// Tools left out of a non-empty enabled tools setting are skipped
+func (m *Server) addTool(s *server.MCPServer, name, description string, specs []toolArg, handler toolHandler) {
+	if len(m.config.EnabledTools) > 0 && !slices.Contains(m.config.EnabledTools, name) {
+		return
+	}
+
+	opts := []mcp.ToolOption{mcp.WithDescription(description)}
+	for _, spec := range specs {
+		opts = append(opts, spec.schemaOption())
+	}
+
+	s.AddTool(mcp.NewTool(name, opts...), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Every log record of this call, down to the repository, carries the request and session IDs
+		ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+		if session := server.ClientSessionFromContext(ctx); session != nil {
+			ctx = logging.WithSessionID(ctx, session.SessionID())
+		}
+
+		args, bindErr := bindArgs(name, specs, request.GetArguments())
+
+		// Invalid calls are counted too, labelled with the arguments bound before the failure
+		version := args.String("version")
+		if version == "" {
+			version = args.String("from_version")
+		}
+		ctx, call := m.telemetry.StartToolCall(ctx, name, version, args.String("package"))
+
+		var result *mcp.CallToolResult
+		var err error
+		if bindErr != nil {
+			m.logger.WarnContext(ctx, "Invalid tool arguments", "tool", name, "error", bindErr)
+			result = m.errorResult(ctx, bindErr)
+		} else {
+			result, err = handler(ctx, args)
+		}
+
+		if err != nil {
+			telemetry.SetToolError(ctx, telemetry.ErrorType(err))
+		}
+		call.End(ctx, responseSize(result))
+		return result, err
+	})
+}
+
+// responseSize returns the number of bytes of text in a tool result
+func responseSize(result *mcp.CallToolResult) int {
+	if result == nil {
+		return 0
+	}
+	size := 0
+	for _, content := range result.Content {
+		switch c := content.(type) {
+		case mcp.TextContent:
+			size += len(c.Text)
+		case mcp.EmbeddedResource:
+			if resource, ok := c.Resource.(mcp.TextResourceContents); ok {
+				size += len(resource.Text)
+			}
+		}
+	}
+	return size
+}
@@ -0,0 +1,347 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/logging"
+	"github.com/tenkoh/recent-go-mcp/internal/service"
+	"github.com/tenkoh/recent-go-mcp/internal/telemetry"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestBindArgs(t *testing.T) {
+	specs := []toolArg{
+		{name: "version", required: true, allowed: func() []string { return []string{"1.21", "1.22"} }, normalize: service.NormalizeVersionArg},
+		{name: "package"},
+		{name: "include_upcoming", kind: boolArg},
+	}
+
+	t.Run("valid arguments", func(t *testing.T) {
+		args, err := bindArgs("go-updates", specs, map[string]any{
+			"version":          " go1.22 ",
+			"package":          "net/http",
+			"include_upcoming": true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if args.String("version") != "1.22" || args.String("package") != "net/http" || !args.Bool("include_upcoming") {
+			t.Errorf("unexpected arguments: %v", args.LogValue())
+		}
+	})
+
+	t.Run("optional arguments default to zero values", func(t *testing.T) {
+		args, err := bindArgs("go-updates", specs, map[string]any{"version": "1.21", "package": ""})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if args.String("package") != "" || args.Bool("include_upcoming") {
+			t.Errorf("expected zero values, got %v", args.LogValue())
+		}
+	})
+
+	tests := []struct {
+		name     string
+		raw      map[string]any
+		argument string
+		allowed  []string
+	}{
+		{name: "missing required", raw: map[string]any{}, argument: "version"},
+		{name: "empty required", raw: map[string]any{"version": "  "}, argument: "version"},
+		{name: "wrong string type", raw: map[string]any{"version": "1.22", "package": 42.0}, argument: "package"},
+		{name: "wrong bool type", raw: map[string]any{"version": "1.22", "include_upcoming": "yes"}, argument: "include_upcoming"},
+		{name: "value not allowed", raw: map[string]any{"version": "1.99"}, argument: "version", allowed: []string{"1.21", "1.22"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := bindArgs("go-updates", specs, tt.raw)
+			if !domain.IsValidationError(err) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+
+			appErr := err.(*domain.ApplicationError)
+			if appErr.Context["argument"] != tt.argument {
+				t.Errorf("expected argument %q in context, got %v", tt.argument, appErr.Context["argument"])
+			}
+			allowed, _ := appErr.Context["allowed"].([]string)
+			if !slices.Equal(allowed, tt.allowed) {
+				t.Errorf("expected allowed values %v, got %v", tt.allowed, allowed)
+			}
+		})
+	}
+
+}
+
+func TestMCPServer_ToolSchemas(t *testing.T) {
+	mcpServer, err := NewMCPServer(config.Default())
+	if err != nil {
+		t.Fatalf("Failed to create MCP server: %v", err)
+	}
+
+	cli, err := client.NewInProcessClient(mcpServer)
+	if err != nil {
+		t.Fatalf("Failed to create in-process client: %v", err)
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := cli.Start(ctx); err != nil {
+		t.Fatalf("Failed to start client: %v", err)
+	}
+	if _, err := cli.Initialize(ctx, mcp.InitializeRequest{
+		Params: mcp.InitializeParams{
+			ProtocolVersion: "2024-11-05",
+			ClientInfo:      mcp.Implementation{Name: "test-client", Version: "0.1.0"},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	t.Run("version and package enums", func(t *testing.T) {
+		tools, err := cli.ListTools(ctx, mcp.ListToolsRequest{})
+		if err != nil {
+			t.Fatalf("Failed to list tools: %v", err)
+		}
+
+		var schema mcp.ToolInputSchema
+		for _, tool := range tools.Tools {
+			if tool.Name == "go-updates" {
+				schema = tool.InputSchema
+			}
+		}
+
+		enumOf := func(property string) []string {
+			data, _ := json.Marshal(schema.Properties[property])
+			var prop struct {
+				Enum []string `json:"enum"`
+			}
+			_ = json.Unmarshal(data, &prop)
+			return prop.Enum
+		}
+
+		versions := enumOf("version")
+		if len(versions) == 0 || versions[0] != "1.13" || !slices.Contains(versions, "1.22") {
+			t.Errorf("expected version enum from the release data, got %v", versions)
+		}
+		if packages := enumOf("package"); !slices.Contains(packages, "net/http") {
+			t.Errorf("expected package enum to contain net/http, got %v", packages)
+		}
+	})
+
+	t.Run("unsupported version lists allowed values", func(t *testing.T) {
+		result, err := cli.CallTool(ctx, mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Name:      "go-godebug",
+				Arguments: map[string]any{"version": "1.99"},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to call tool: %v", err)
+		}
+		if !result.IsError {
+			t.Fatal("expected an error result")
+		}
+
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "allowed values: 1.13, 1.14") {
+			t.Errorf("expected allowed versions in error, got %q", text)
+		}
+	})
+}
+
+func TestMCPServer_ToolTelemetry(t *testing.T) {
+	// The server picks up the global provider, so install an in-memory one for this test
+	spans := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	mcpServer, err := NewMCPServer(config.Default())
+	if err != nil {
+		t.Fatalf("Failed to create MCP server: %v", err)
+	}
+
+	cli, err := client.NewInProcessClient(mcpServer)
+	if err != nil {
+		t.Fatalf("Failed to create in-process client: %v", err)
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := cli.Start(ctx); err != nil {
+		t.Fatalf("Failed to start client: %v", err)
+	}
+	if _, err := cli.Initialize(ctx, mcp.InitializeRequest{
+		Params: mcp.InitializeParams{
+			ProtocolVersion: "2024-11-05",
+			ClientInfo:      mcp.Implementation{Name: "test-client", Version: "0.1.0"},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	callTool := func(t *testing.T, name string, args map[string]any) tracetest.SpanStub {
+		t.Helper()
+		spans.Reset()
+		if _, err := cli.CallTool(ctx, mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Name: name, Arguments: args},
+		}); err != nil {
+			t.Fatalf("Failed to call tool: %v", err)
+		}
+		for _, span := range spans.GetSpans() {
+			if span.Name == "tool "+name {
+				return span
+			}
+		}
+		t.Fatalf("no span recorded for %s", name)
+		return tracetest.SpanStub{}
+	}
+	attr := func(span tracetest.SpanStub, key string) string {
+		for _, kv := range span.Attributes {
+			if string(kv.Key) == key {
+				return kv.Value.Emit()
+			}
+		}
+		return ""
+	}
+
+	t.Run("successful call", func(t *testing.T) {
+		span := callTool(t, "go-updates", map[string]any{"version": "go1.22", "package": "slices"})
+
+		if attr(span, string(telemetry.AttrVersion)) != "1.22" || attr(span, string(telemetry.AttrPackage)) != "slices" {
+			t.Errorf("expected normalized version and package attributes, got %v", span.Attributes)
+		}
+		if attr(span, string(telemetry.AttrErrorType)) != "" {
+			t.Errorf("expected no error type, got %v", span.Attributes)
+		}
+
+		var serviceSpan bool
+		for _, child := range spans.GetSpans() {
+			if child.Name == "FeatureService.QueryFeatures" && child.Parent.SpanID() == span.SpanContext.SpanID() {
+				serviceSpan = true
+			}
+		}
+		if !serviceSpan {
+			t.Error("expected a FeatureService span under the tool span")
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		span := callTool(t, "go-godebug", map[string]any{"version": "1.99"})
+
+		if got := attr(span, string(telemetry.AttrErrorType)); got != string(domain.ErrTypeValidation) {
+			t.Errorf("expected error type %s, got %q", domain.ErrTypeValidation, got)
+		}
+	})
+}
+
+func TestMCPServer_LogCorrelation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.log")
+	logger, closeLog, err := logging.New(logging.Options{Level: "debug", Format: logging.FormatJSON, File: path})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	defer closeLog()
+
+	m, err := New(config.Default(), WithLogger(logger))
+	if err != nil {
+		t.Fatalf("Failed to create wrapper: %v", err)
+	}
+	mcpServer, err := m.NewMCPServer()
+	if err != nil {
+		t.Fatalf("Failed to create MCP server: %v", err)
+	}
+
+	// The HTTP transport assigns session IDs, unlike the in-process client
+	ts := httptest.NewServer(m.HTTPHandler(mcpServer, http.NotFoundHandler()))
+	defer ts.Close()
+
+	cli, err := client.NewStreamableHttpClient(ts.URL + mcpEndpoint)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP client: %v", err)
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := cli.Start(ctx); err != nil {
+		t.Fatalf("Failed to start client: %v", err)
+	}
+	if _, err := cli.Initialize(ctx, mcp.InitializeRequest{
+		Params: mcp.InitializeParams{
+			ProtocolVersion: "2024-11-05",
+			ClientInfo:      mcp.Implementation{Name: "test-client", Version: "0.1.0"},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to initialize client: %v", err)
+	}
+
+	for _, v := range []string{"1.21", "1.22"} {
+		if _, err := cli.CallTool(ctx, mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Name: "go-updates", Arguments: map[string]any{"version": v}},
+		}); err != nil {
+			t.Fatalf("Failed to call tool: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+
+	// Handler, service and repository records of one call share its request ID; each call gets a new one
+	requestIDs := make(map[string][]string)
+	sessionIDs := make(map[string]bool)
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log record %q: %v", line, err)
+		}
+		id, ok := record[logging.RequestIDKey].(string)
+		if !ok {
+			continue
+		}
+		sessionID, _ := record[logging.SessionIDKey].(string)
+		sessionIDs[sessionID] = true
+
+		source := record["msg"].(string)
+		if component, ok := record["component"].(string); ok {
+			source = component
+		}
+		requestIDs[id] = append(requestIDs[id], source)
+	}
+
+	if len(requestIDs) != 2 {
+		t.Fatalf("expected 2 request IDs, got %v", requestIDs)
+	}
+	for id, sources := range requestIDs {
+		for _, want := range []string{"Request processed successfully", "FeatureService", "ReleaseRepository"} {
+			if !slices.Contains(sources, want) {
+				t.Errorf("expected a %q record for request %s, got %v", want, id, sources)
+			}
+		}
+	}
+	if len(sessionIDs) != 1 || sessionIDs[""] {
+		t.Errorf("expected every record to carry the same session ID, got %v", sessionIDs)
+	}
+}
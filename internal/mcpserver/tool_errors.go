@@ -0,0 +1,134 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/telemetry"
+)
+
+// errorResourceURI prefixes the URI of the JSON error payload attached to failed tool calls
+const errorResourceURI = "recent-go-mcp://errors/"
+
+// toolError is the structured payload returned with every failed tool call
+type toolError struct {
+	Type        domain.ErrType `json:"type"`
+	Message     string         `json:"message"` // Consistent user-facing summary of the error type
+	Detail      string         `json:"detail"`  // What went wrong in this call
+	Operation   string         `json:"operation,omitempty"`
+	Context     map[string]any `json:"context,omitempty"`
+	Suggestions []string       `json:"suggestions,omitempty"`
+	Retryable   bool           `json:"retryable"`
+	RetryHint   string         `json:"retry_hint"`
+}
+
+// ClassifyError picks the ApplicationError that best describes err
+// Services wrap lower-level errors, so a client error deeper in the chain (e.g., an unknown version)
+// is preferred over the generic service error around it; contexts of the whole chain are merged
+func ClassifyError(err error) *domain.ApplicationError {
+	var chain []*domain.ApplicationError
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if appErr, ok := e.(*domain.ApplicationError); ok {
+			chain = append(chain, appErr)
+		}
+	}
+
+	if len(chain) == 0 {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return domain.NewServiceError("", "request canceled", err)
+		}
+		return domain.NewServiceError("", err.Error(), nil)
+	}
+
+	chosen := chain[0]
+	for _, appErr := range chain {
+		if appErr.Type.IsClientError() {
+			chosen = appErr
+			break
+		}
+	}
+
+	// Inner contexts first so the outer, more specific values win
+	merged := make(map[string]any)
+	for i := len(chain) - 1; i >= 0; i-- {
+		maps.Copy(merged, chain[i].Context)
+	}
+
+	return &domain.ApplicationError{
+		Type:      chosen.Type,
+		Operation: chosen.Operation,
+		Message:   chosen.Message,
+		Context:   merged,
+	}
+}
+
+// newToolError builds the payload for err, adding the supported values a client can retry with
+func (m *Server) newToolError(err error) toolError {
+	appErr := ClassifyError(err)
+
+	payload := toolError{
+		Type:      appErr.Type,
+		Message:   appErr.Type.UserMessage(),
+		Detail:    appErr.Message,
+		Operation: appErr.Operation,
+		Context:   appErr.Context,
+		Retryable: appErr.Type.Retryable(),
+		RetryHint: appErr.Type.RetryHint(),
+	}
+	if len(payload.Context) == 0 {
+		payload.Context = nil
+	}
+
+	if allowed, ok := appErr.Context["allowed"].([]string); ok {
+		payload.Suggestions = append(payload.Suggestions, "Use one of the allowed values: "+strings.Join(allowed, ", "))
+	}
+
+	if appErr.Type == domain.ErrTypeNotFound || appErr.Type == domain.ErrTypeVersion {
+		if _, ok := appErr.Context["version"]; ok {
+			payload.Context["supported_versions"] = m.supportedVersions()
+			payload.Suggestions = append(payload.Suggestions, "Call go-versions to list the supported Go versions")
+		}
+		if _, ok := appErr.Context["package"]; ok {
+			payload.Suggestions = append(payload.Suggestions, "Use a standard library import path such as \"net/http\" or \"slices\"")
+		}
+	}
+
+	return payload
+}
+
+// errorResult converts err into a tool error result and records its type on the tool call in ctx
+// The text content is for people and LLMs; the embedded JSON resource lets clients react programmatically
+func (m *Server) errorResult(ctx context.Context, err error) *mcp.CallToolResult {
+	payload := m.newToolError(err)
+	telemetry.SetToolError(ctx, string(payload.Type))
+
+	var text strings.Builder
+	text.WriteString(payload.Message + ": " + payload.Detail)
+	for _, suggestion := range payload.Suggestions {
+		text.WriteString("\n- " + suggestion)
+	}
+	text.WriteString("\n" + payload.RetryHint)
+
+	content := []mcp.Content{mcp.NewTextContent(text.String())}
+
+	data, marshalErr := json.Marshal(map[string]toolError{"error": payload})
+	if marshalErr != nil {
+		m.logger.ErrorContext(ctx, "Failed to encode error payload", "error", marshalErr)
+	} else {
+		content = append(content, mcp.NewEmbeddedResource(mcp.TextResourceContents{
+			URI:      errorResourceURI + string(payload.Type),
+			MIMEType: "application/json",
+			Text:     string(data),
+		}))
+	}
+
+	return &mcp.CallToolResult{
+		Content: content,
+		IsError: true,
+	}
+}
@@ -0,0 +1,119 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Run("client error inside a service error", func(t *testing.T) {
+		inner := domain.NewNotFoundError("GetReleasesUpToVersion", "release not found").
+			WithContext("version", "1.99")
+		outer := domain.NewServiceError("QueryFeatures", "failed to get releases up to version", inner).
+			WithContext("package", "slices")
+
+		appErr := ClassifyError(outer)
+		if appErr.Type != domain.ErrTypeNotFound || appErr.Message != "release not found" {
+			t.Errorf("expected the inner not found error, got %v", appErr)
+		}
+		if appErr.Context["version"] != "1.99" || appErr.Context["package"] != "slices" {
+			t.Errorf("expected contexts of the whole chain, got %v", appErr.Context)
+		}
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		err := domain.NewServiceError("QueryFeatures", "failed", domain.NewRepositoryError("load", "broken", nil))
+		if appErr := ClassifyError(err); appErr.Type != domain.ErrTypeService {
+			t.Errorf("expected the outer service error, got %v", appErr)
+		}
+	})
+
+	t.Run("plain errors", func(t *testing.T) {
+		if appErr := ClassifyError(context.Canceled); appErr.Type != domain.ErrTypeService || appErr.Message != "request canceled" {
+			t.Errorf("expected canceled service error, got %v", appErr)
+		}
+		if appErr := ClassifyError(errors.New("boom")); appErr.Type != domain.ErrTypeService || appErr.Message != "boom" {
+			t.Errorf("expected service error, got %v", appErr)
+		}
+	})
+}
+
+func TestMCPServer_ErrorResult(t *testing.T) {
+	m, err := New(config.Default())
+	if err != nil {
+		t.Fatalf("Failed to create dependencies: %v", err)
+	}
+
+	decode := func(t *testing.T, result *mcp.CallToolResult) (string, toolError) {
+		t.Helper()
+		if !result.IsError || len(result.Content) != 2 {
+			t.Fatalf("expected an error result with text and JSON content, got %+v", result)
+		}
+
+		text := result.Content[0].(mcp.TextContent).Text
+		resource := result.Content[1].(mcp.EmbeddedResource).Resource.(mcp.TextResourceContents)
+		if resource.MIMEType != "application/json" || !strings.HasPrefix(resource.URI, errorResourceURI) {
+			t.Errorf("unexpected resource %s (%s)", resource.URI, resource.MIMEType)
+		}
+
+		var payload struct {
+			Error toolError `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(resource.Text), &payload); err != nil {
+			t.Fatalf("invalid JSON payload: %v", err)
+		}
+		return text, payload.Error
+	}
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := m.featureService.QueryFeatures(context.Background(), domain.FeatureQuery{Version: "1.99"})
+		text, payload := decode(t, m.errorResult(context.Background(), err))
+
+		if payload.Type != domain.ErrTypeNotFound || payload.Message != "Not found" || payload.Retryable {
+			t.Errorf("unexpected payload %+v", payload)
+		}
+		versions, _ := payload.Context["supported_versions"].([]any)
+		if !slices.Contains(versions, any("1.22")) {
+			t.Errorf("expected supported versions in context, got %v", payload.Context)
+		}
+		if !slices.Contains(payload.Suggestions, "Call go-versions to list the supported Go versions") {
+			t.Errorf("expected go-versions suggestion, got %v", payload.Suggestions)
+		}
+		if !strings.HasPrefix(text, "Not found: ") || !strings.Contains(text, payload.RetryHint) {
+			t.Errorf("unexpected text %q", text)
+		}
+	})
+
+	t.Run("validation error with allowed values", func(t *testing.T) {
+		err := domain.NewValidationError("go-updates", "argument 'locale' does not accept 'xx'", nil).
+			WithContext("argument", "locale").
+			WithContext("allowed", []string{"en", "ja"})
+		text, payload := decode(t, m.errorResult(context.Background(), err))
+
+		if payload.Type != domain.ErrTypeValidation || payload.Context["argument"] != "locale" {
+			t.Errorf("unexpected payload %+v", payload)
+		}
+		if !strings.Contains(text, "Use one of the allowed values: en, ja") {
+			t.Errorf("expected allowed values in text, got %q", text)
+		}
+	})
+
+	t.Run("every error type has guidance", func(t *testing.T) {
+		for _, errType := range []domain.ErrType{
+			domain.ErrTypeRepository, domain.ErrTypeVersion, domain.ErrTypeService,
+			domain.ErrTypeValidation, domain.ErrTypeNotFound, domain.ErrTypeInvalidInput,
+		} {
+			if errType.UserMessage() == "Unexpected error" {
+				t.Errorf("missing user message for %s", errType)
+			}
+		}
+	})
+}
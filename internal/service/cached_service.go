@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"github.com/tenkoh/recent-go-mcp/internal/cache"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// featureKey identifies a cached response by the normalized query and the generation it was computed in
+type featureKey struct {
+	generation uint64
+	query      domain.FeatureQuery
+}
+
+// CachedFeatureService decorates a FeatureService with a bounded LRU cache
+// Cached responses are shared between callers and must be treated as read-only
+type CachedFeatureService struct {
+	next  domain.FeatureService
+	cache *cache.LRU[featureKey, *domain.FeatureResponse]
+	// generation is bumped by Invalidate; a response computed before a reload is added under the old
+	// generation, so it is never served even when the reload purged the cache while it was computed
+	generation atomic.Uint64
+}
+
+// NewCachedFeatureService creates a caching decorator holding at most size responses
+func NewCachedFeatureService(next domain.FeatureService, size int) *CachedFeatureService {
+	return &CachedFeatureService{
+		next:  next,
+		cache: cache.NewLRU[featureKey, *domain.FeatureResponse](size),
+	}
+}
+
+// GetFeaturesForVersion returns a cached response or delegates to the wrapped service
+func (s *CachedFeatureService) GetFeaturesForVersion(ctx context.Context, targetVersion string, packageName string) (*domain.FeatureResponse, error) {
+	return s.QueryFeatures(ctx, domain.FeatureQuery{Version: targetVersion, Package: packageName})
+}
+
+// QueryFeatures returns a cached response or delegates to the wrapped service
+// Only the cache key is normalized; the wrapped service receives the query as given, so caching never changes
+// which arguments are accepted. Errors are never cached so that transient failures do not stick
+func (s *CachedFeatureService) QueryFeatures(ctx context.Context, query domain.FeatureQuery) (*domain.FeatureResponse, error) {
+	key := featureKey{generation: s.generation.Load(), query: normalizeQuery(query)}
+
+	if response, ok := s.cache.Get(key); ok {
+		return response, nil
+	}
+
+	response, err := s.next.QueryFeatures(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Add(key, response)
+	return response, nil
+}
+
+// Invalidate drops all cached responses, e.g. after the release data was reloaded
+// Responses still being computed from the previous data are not served afterwards
+func (s *CachedFeatureService) Invalidate() {
+	s.generation.Add(1)
+	s.cache.Purge()
+}
+
+// Stats returns the cache counters including the hit rate
+func (s *CachedFeatureService) Stats() cache.Stats {
+	return s.cache.Stats()
+}
+
+// listingKey identifies a formatted feature listing by the normalized query, its presentation and the generation it was rendered in
+type listingKey struct {
+	generation uint64
+	format     string
+	query      domain.FeatureQuery
+	opts       domain.FormatOptions
+}
+
+// FeatureListingCache is a bounded LRU cache of formatted feature listings
+// Listings are keyed on the normalized query rather than on a response, so output never goes stale when a response is mutated
+type FeatureListingCache struct {
+	cache *cache.LRU[listingKey, string]
+	// generation is bumped by Invalidate, like in CachedFeatureService
+	generation atomic.Uint64
+}
+
+// NewFeatureListingCache creates a cache holding at most size formatted listings
+func NewFeatureListingCache(size int) *FeatureListingCache {
+	return &FeatureListingCache{cache: cache.NewLRU[listingKey, string](size)}
+}
+
+// Listing returns the cached listing of query in format, or calls render and caches its output; errors are not cached
+func (c *FeatureListingCache) Listing(format string, query domain.FeatureQuery, opts domain.FormatOptions, render func() (string, error)) (string, error) {
+	key := listingKey{generation: c.generation.Load(), format: format, query: normalizeQuery(query), opts: opts}
+
+	if text, ok := c.cache.Get(key); ok {
+		return text, nil
+	}
+
+	text, err := render()
+	if err != nil {
+		return "", err
+	}
+	c.cache.Add(key, text)
+	return text, nil
+}
+
+// Invalidate drops all cached listings, e.g. after the release data was reloaded or a format was replaced
+// Listings still being rendered from the previous data are not served afterwards
+func (c *FeatureListingCache) Invalidate() {
+	c.generation.Add(1)
+	c.cache.Purge()
+}
+
+// Stats returns the cache counters including the hit rate
+func (c *FeatureListingCache) Stats() cache.Stats {
+	return c.cache.Stats()
+}
+
+// normalizeQuery returns query with the version and package arguments normalized, for use as a cache key
+func normalizeQuery(query domain.FeatureQuery) domain.FeatureQuery {
+	query.Version = NormalizeVersionArg(query.Version)
+	query.Package = NormalizePackageArg(query.Package)
+	return query
+}
+
+// NormalizeVersionArg trims whitespace and the optional "go" prefix (e.g., " go1.22 " -> "1.22")
+func NormalizeVersionArg(v string) string {
+	return strings.TrimPrefix(strings.TrimSpace(v), "go")
+}
+
+// NormalizePackageArg trims whitespace and trailing slashes from an import path
+func NormalizePackageArg(pkg string) string {
+	return strings.TrimRight(strings.TrimSpace(pkg), "/")
+}
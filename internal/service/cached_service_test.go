@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// countingFeatureService counts calls reaching the wrapped service
+type countingFeatureService struct {
+	calls int
+	args  [][2]string
+	// during runs inside each call, e.g. to reload while a response is computed
+	during func()
+}
+
+func (s *countingFeatureService) GetFeaturesForVersion(ctx context.Context, targetVersion string, packageName string) (*domain.FeatureResponse, error) {
+	return s.QueryFeatures(ctx, domain.FeatureQuery{Version: targetVersion, Package: packageName})
+}
+
+func (s *countingFeatureService) QueryFeatures(ctx context.Context, query domain.FeatureQuery) (*domain.FeatureResponse, error) {
+	s.calls++
+	s.args = append(s.args, [2]string{query.Version, query.Package})
+	if s.during != nil {
+		s.during()
+	}
+	if query.Version == "9.99" {
+		return nil, domain.NewNotFoundError("QueryFeatures", "no releases found up to version")
+	}
+	return &domain.FeatureResponse{ToVersion: query.Version}, nil
+}
+
+func TestCachedFeatureService(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("normalized arguments share an entry", func(t *testing.T) {
+		next := &countingFeatureService{}
+		cached := NewCachedFeatureService(next, 8)
+
+		first, err := cached.GetFeaturesForVersion(ctx, "1.22", "net/http")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		second, err := cached.GetFeaturesForVersion(ctx, " go1.22 ", "net/http/")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if next.calls != 1 {
+			t.Errorf("expected 1 call to wrapped service, got %d", next.calls)
+		}
+		if first != second {
+			t.Error("expected the cached response to be returned")
+		}
+		if next.args[0] != [2]string{"1.22", "net/http"} {
+			t.Errorf("expected the arguments of the first call, got %v", next.args[0])
+		}
+
+		stats := cached.Stats()
+		if stats.Hits != 1 || stats.Misses != 1 {
+			t.Errorf("expected 1 hit and 1 miss, got %+v", stats)
+		}
+	})
+
+	t.Run("the wrapped service receives the query as given", func(t *testing.T) {
+		next := &countingFeatureService{}
+		cached := NewCachedFeatureService(next, 8)
+
+		cached.GetFeaturesForVersion(ctx, " go1.22 ", "net/http/")
+
+		if next.args[0] != [2]string{" go1.22 ", "net/http/"} {
+			t.Errorf("expected the original arguments, got %v", next.args[0])
+		}
+	})
+
+	t.Run("query options are part of the key", func(t *testing.T) {
+		next := &countingFeatureService{}
+		cached := NewCachedFeatureService(next, 8)
+
+		cached.GetFeaturesForVersion(ctx, "1.21", "")
+		cached.QueryFeatures(ctx, domain.FeatureQuery{Version: "1.21", IncludeUpcoming: true})
+
+		if next.calls != 2 {
+			t.Errorf("expected 2 calls to wrapped service, got %d", next.calls)
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		next := &countingFeatureService{}
+		cached := NewCachedFeatureService(next, 8)
+
+		for range 2 {
+			if _, err := cached.GetFeaturesForVersion(ctx, "9.99", ""); !domain.IsNotFoundError(err) {
+				t.Fatalf("expected not found error, got %v", err)
+			}
+		}
+
+		if next.calls != 2 {
+			t.Errorf("expected 2 calls to wrapped service, got %d", next.calls)
+		}
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		next := &countingFeatureService{}
+		cached := NewCachedFeatureService(next, 8)
+
+		cached.GetFeaturesForVersion(ctx, "1.22", "")
+		cached.Invalidate()
+		cached.GetFeaturesForVersion(ctx, "1.22", "")
+
+		if next.calls != 2 {
+			t.Errorf("expected 2 calls after invalidation, got %d", next.calls)
+		}
+	})
+
+	t.Run("responses computed during a reload are not served", func(t *testing.T) {
+		next := &countingFeatureService{}
+		cached := NewCachedFeatureService(next, 8)
+
+		next.during = cached.Invalidate
+		cached.GetFeaturesForVersion(ctx, "1.22", "")
+		next.during = nil
+		cached.GetFeaturesForVersion(ctx, "1.22", "")
+		cached.GetFeaturesForVersion(ctx, "1.22", "")
+
+		if next.calls != 2 {
+			t.Errorf("expected the stale response to be recomputed once, got %d calls", next.calls)
+		}
+	})
+}
+
+// countingFormatter counts Format calls reaching the wrapped formatter
+type countingFormatter struct {
+	calls int
+}
+
+func (f *countingFormatter) Format(response *domain.FeatureResponse, opts domain.FormatOptions) (string, error) {
+	f.calls++
+	return "# Go " + opts.Version, nil
+}
+
+func TestFeatureListingCache(t *testing.T) {
+	listings := NewFeatureListingCache(8)
+	calls := 0
+	render := func() (string, error) {
+		calls++
+		return "# Go 1.22", nil
+	}
+	opts := domain.FormatOptions{Version: "1.22"}
+
+	first, _ := listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: "1.22"}, opts, render)
+	second, _ := listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: " go1.22 "}, opts, render)
+
+	if first != second {
+		t.Errorf("expected identical output, got %q and %q", first, second)
+	}
+	if calls != 1 {
+		t.Errorf("expected normalized queries to share a listing, got %d renders", calls)
+	}
+
+	listings.Listing(FormatLLM, domain.FeatureQuery{Version: "1.22"}, opts, render)
+	if calls != 2 {
+		t.Errorf("expected a distinct format to miss the cache, got %d renders", calls)
+	}
+
+	listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: "1.22"}, domain.FormatOptions{Version: "1.22", Locale: "ja"}, render)
+	if calls != 3 {
+		t.Errorf("expected distinct options to miss the cache, got %d renders", calls)
+	}
+
+	failing := func() (string, error) {
+		calls++
+		return "", errors.New("boom")
+	}
+	listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: "1.21"}, opts, failing)
+	listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: "1.21"}, opts, failing)
+	if calls != 5 {
+		t.Errorf("expected errors not to be cached, got %d renders", calls)
+	}
+
+	listings.Invalidate()
+	listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: "1.22"}, opts, render)
+	if calls != 6 {
+		t.Errorf("expected Invalidate to drop cached listings, got %d renders", calls)
+	}
+
+	// A reload between rendering and caching must not leave the stale listing behind
+	reloading := func() (string, error) {
+		calls++
+		listings.Invalidate()
+		return "# Go 1.22 (stale)", nil
+	}
+	listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: "1.20"}, opts, reloading)
+	if text, _ := listings.Listing(FormatMarkdown, domain.FeatureQuery{Version: "1.20"}, opts, render); text != "# Go 1.22" || calls != 8 {
+		t.Errorf("expected the listing rendered during a reload to be dropped, got %q after %d renders", text, calls)
+	}
+}
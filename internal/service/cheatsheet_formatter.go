@@ -0,0 +1,106 @@
+package service
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// cheatsheetFormatter renders one Markdown table per theme of older patterns and the APIs replacing them
+// Only package changes with an example that a theme rule maps are listed, so the themes decide the rows
+type cheatsheetFormatter struct {
+	comparator domain.VersionComparator
+	options    []ResponseFormatterOption
+	themes     []domain.CheatsheetTheme
+}
+
+// cheatsheetEntry is the first release of a package change with an example
+type cheatsheetEntry struct {
+	version string
+	change  domain.PackageChange
+}
+
+// Format implements domain.FeatureFormatter
+func (f *cheatsheetFormatter) Format(response *domain.FeatureResponse, opts domain.FormatOptions) (string, error) {
+	r := formatterFor(f.comparator, f.options, opts)
+	entries := firstExamples(r, response, opts.Package)
+
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	for _, theme := range f.themes {
+		var rules []domain.CheatsheetRule
+		for _, rule := range theme.Rules {
+			if _, exists := entries[cheatsheetKey(rule)]; exists {
+				rules = append(rules, rule)
+			}
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		// Oldest replacements first; rules of the same release keep the order of the theme
+		slices.SortStableFunc(rules, func(a, b domain.CheatsheetRule) int {
+			return f.comparator.Compare(entries[cheatsheetKey(a)].version, entries[cheatsheetKey(b)].version)
+		})
+
+		table := newMarkdownTable(r.t("Old pattern"), r.t("Modern replacement"), r.t("Since"))
+		for _, rule := range rules {
+			entry := entries[cheatsheetKey(rule)]
+			table.addRow(rule.Replaces, r.modernReplacement(rule.Package, entry.change), "Go "+entry.version)
+		}
+		builder.WriteString("## " + r.localize(theme.Title, theme.TitleTranslations) + "\n\n")
+		table.writeTo(&builder)
+	}
+
+	if builder.Len() == 0 {
+		return r.tf("No cheat sheet entries found for your project (Go %s).", response.ToVersion) + "\n", nil
+	}
+	// Drop the blank line after the last table
+	return "# " + r.tf("Go Cheat Sheet (Go %s)", response.ToVersion) + "\n\n" + strings.TrimSuffix(builder.String(), "\n"), nil
+}
+
+// cheatsheetKey identifies the API a rule maps
+func cheatsheetKey(rule domain.CheatsheetRule) domain.CheatsheetRule {
+	return domain.CheatsheetRule{Package: rule.Package, Function: rule.Function}
+}
+
+// firstExamples returns the oldest release of every package change with an example, keyed like theme rules
+func firstExamples(r *DefaultResponseFormatter, response *domain.FeatureResponse, packageName string) map[domain.CheatsheetRule]cheatsheetEntry {
+	entries := make(map[domain.CheatsheetRule]cheatsheetEntry)
+	for _, version := range r.featureVersions(response) {
+		for pkg, changes := range filterPackages(response.VersionPackages[version], packageName) {
+			for _, change := range changes {
+				key := cheatsheetKey(domain.CheatsheetRule{Package: pkg, Function: change.Function})
+				if _, seen := entries[key]; seen || change.Example == "" {
+					continue
+				}
+				entries[key] = cheatsheetEntry{version: version, change: change}
+			}
+		}
+	}
+	return entries
+}
+
+// modernReplacement returns the example line using the API, or the API name when examples are off
+// Examples set up variables before the call, so the first line mentioning the function is picked
+func (f *DefaultResponseFormatter) modernReplacement(pkg string, change domain.PackageChange) string {
+	if !f.examples {
+		return "`" + apiName(pkg, change) + "`"
+	}
+
+	lines := strings.Split(strings.TrimSpace(change.Example), "\n")
+	line := lines[0]
+	if change.Function != "" {
+		name := change.Function[strings.LastIndex(change.Function, ".")+1:]
+		if i := slices.IndexFunc(lines, func(l string) bool { return strings.Contains(l, name) }); i >= 0 {
+			line = lines[i]
+		}
+	}
+	// Close blocks opened on the picked line
+	line = strings.TrimSpace(line)
+	if strings.HasSuffix(line, "{") {
+		line += " ... }"
+	}
+	return "`" + line + "`"
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+// newCheatsheetFixture returns a listing where slices.Contains is repeated in a later release and slices.Sort has no example
+func newCheatsheetFixture() *domain.FeatureResponse {
+	contains := newFormatFixture().PackageInfo["slices"][0]
+	join := domain.PackageChange{Function: "Join", Description: "joins errors", Impact: "new", Example: "err := errors.Join(err1, err2)"}
+	return &domain.FeatureResponse{
+		ToVersion:   "1.22",
+		PackageInfo: map[string][]domain.PackageChange{"slices": {contains}, "errors": {join}},
+		VersionPackages: map[string]map[string][]domain.PackageChange{
+			"1.20": {"errors": {join}},
+			"1.21": {"slices": {contains, {Function: "Sort", Description: "sorts a slice", Impact: "new"}}},
+			"1.22": {"slices": {
+				{Function: "Contains", Description: "faster", Impact: "performance", Example: "slices.Contains(s, v)"},
+				{Function: "Concat", Description: "concatenates slices", Impact: "new", Example: "s := slices.Concat(a, b)"},
+			}},
+		},
+	}
+}
+
+func newCheatsheetThemes() []domain.CheatsheetTheme {
+	return []domain.CheatsheetTheme{
+		{Name: "collections", Title: "Collections", Rules: []domain.CheatsheetRule{
+			{Package: "slices", Function: "Concat", Replaces: "`append(append(a, b...), c...)`"},
+			{Package: "slices", Function: "Contains", Replaces: "a | loop"},
+			{Package: "slices", Function: "Sort", Replaces: "`sort.Ints(s)`"},
+		}},
+		{Name: "errors", Title: "Errors", TitleTranslations: map[string]string{"ja": "エラー"}, Rules: []domain.CheatsheetRule{
+			{Package: "errors", Function: "Join", Replaces: "a multi-error type"},
+		}},
+		{Name: "logging", Title: "Logging", Rules: []domain.CheatsheetRule{
+			{Package: "log/slog", Replaces: "`log.Printf`"},
+		}},
+	}
+}
+
+func TestCheatsheetFormatter(t *testing.T) {
+	formatter := &cheatsheetFormatter{comparator: version.NewSemanticVersionComparator(), themes: newCheatsheetThemes()}
+
+	t.Run("with examples", func(t *testing.T) {
+		result, err := formatter.Format(newCheatsheetFixture(), domain.FormatOptions{Version: "1.22", IncludeExamples: true})
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+
+		expected := "# Go Cheat Sheet (Go 1.22)\n" +
+			"\n" +
+			"## Collections\n" +
+			"\n" +
+			"| Old pattern | Modern replacement | Since |\n" +
+			"| --- | --- | --- |\n" +
+			"| a \\| loop | `if slices.Contains(s, v) { ... }` | Go 1.21 |\n" +
+			"| `append(append(a, b...), c...)` | `s := slices.Concat(a, b)` | Go 1.22 |\n" +
+			"\n" +
+			"## Errors\n" +
+			"\n" +
+			"| Old pattern | Modern replacement | Since |\n" +
+			"| --- | --- | --- |\n" +
+			"| a multi-error type | `err := errors.Join(err1, err2)` | Go 1.20 |\n"
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("localized package filter without examples", func(t *testing.T) {
+		result, err := formatter.Format(newCheatsheetFixture(), domain.FormatOptions{Version: "1.22", Package: "errors", Locale: "ja"})
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+
+		expected := "# Go チートシート (Go 1.22)\n" +
+			"\n" +
+			"## エラー\n" +
+			"\n" +
+			"| 従来の書き方 | モダンな書き方 | 導入バージョン |\n" +
+			"| --- | --- | --- |\n" +
+			"| a multi-error type | `errors.Join` | Go 1.20 |\n"
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("no mapped entries", func(t *testing.T) {
+		result, _ := formatter.Format(newCheatsheetFixture(), domain.FormatOptions{Version: "1.22", Package: "net/http"})
+		if expected := "No cheat sheet entries found for your project (Go 1.22).\n"; result != expected {
+			t.Errorf("Expected %q, got %q", expected, result)
+		}
+	})
+}
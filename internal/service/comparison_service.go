@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// DefaultComparisonService implements ComparisonService on top of a FeatureService
+type DefaultComparisonService struct {
+	features   domain.FeatureService
+	comparator domain.VersionComparator
+}
+
+// NewComparisonService creates a new comparison service
+func NewComparisonService(features domain.FeatureService, comparator domain.VersionComparator) domain.ComparisonService {
+	return &DefaultComparisonService{
+		features:   features,
+		comparator: comparator,
+	}
+}
+
+// CompareVersions returns cumulative feature counts for both versions and the packages changed between them
+func (s *DefaultComparisonService) CompareVersions(ctx context.Context, version, otherVersion string, includeExperimental bool) (*domain.VersionComparison, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Validate input
+	if version == "" || otherVersion == "" {
+		return nil, domain.NewValidationError("CompareVersions", "both versions are required", nil)
+	}
+
+	if s.comparator.Compare(version, otherVersion) == 0 {
+		return nil, domain.NewValidationError("CompareVersions", "versions must differ", nil).
+			WithContext("version", version)
+	}
+
+	// Versions may be given in any order; compare the older against the newer
+	fromVersion, toVersion := version, otherVersion
+	if s.comparator.Compare(fromVersion, toVersion) > 0 {
+		fromVersion, toVersion = toVersion, fromVersion
+	}
+
+	// The newer version's response holds the per-version data of both
+	response, err := s.features.QueryFeatures(ctx, domain.FeatureQuery{Version: toVersion, IncludeExperimental: includeExperimental})
+	if err != nil {
+		return nil, lookupError("CompareVersions", "version not found", err).
+			WithContext("version", toVersion)
+	}
+
+	if _, exists := response.VersionChanges[fromVersion]; !exists {
+		return nil, domain.NewNotFoundError("CompareVersions", "version not found").
+			WithContext("version", fromVersion)
+	}
+
+	comparison := &domain.VersionComparison{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Packages:    make([]domain.PackageComparison, 0),
+	}
+
+	versions := slices.SortedFunc(maps.Keys(response.VersionChanges), s.comparator.Compare)
+
+	fromAPIs := make(map[string]int)
+	toAPIs := make(map[string]int)
+	changedIn := make(map[string][]string)
+
+	for _, v := range versions {
+		older := s.comparator.Compare(v, fromVersion) <= 0
+
+		for _, change := range response.VersionChanges[v] {
+			countChange(&comparison.To, change)
+			if older {
+				countChange(&comparison.From, change)
+			}
+		}
+
+		for pkg, changes := range response.VersionPackages[v] {
+			apis, breaking := countPackageChanges(changes)
+
+			toAPIs[pkg] += apis
+			comparison.To.NewAPIs += apis
+			comparison.To.BreakingChanges += breaking
+
+			if older {
+				fromAPIs[pkg] += apis
+				comparison.From.NewAPIs += apis
+				comparison.From.BreakingChanges += breaking
+			} else {
+				changedIn[pkg] = append(changedIn[pkg], v)
+			}
+		}
+	}
+
+	comparison.From.PackagesTouched = len(fromAPIs)
+	comparison.To.PackagesTouched = len(toAPIs)
+
+	for _, pkg := range slices.Sorted(maps.Keys(changedIn)) {
+		comparison.Packages = append(comparison.Packages, domain.PackageComparison{
+			Package:     pkg,
+			FromNewAPIs: fromAPIs[pkg],
+			ToNewAPIs:   toAPIs[pkg],
+			ChangedIn:   changedIn[pkg],
+		})
+	}
+
+	comparison.Summary = comparisonSummary(fmt.Sprintf, comparison)
+
+	return comparison, nil
+}
+
+// countChange adds a general change to the totals
+func countChange(totals *domain.ComparisonTotals, change domain.Change) {
+	if change.Category == "language" {
+		totals.LanguageChanges++
+	}
+	if change.Impact == "breaking" {
+		totals.BreakingChanges++
+	}
+}
+
+// countPackageChanges returns the number of new APIs and breaking changes among package changes
+// Only new entries naming a function or type count as APIs; package-level notes do not
+func countPackageChanges(changes []domain.PackageChange) (apis, breaking int) {
+	for _, change := range changes {
+		if change.Impact == "new" && (change.Function != "" || change.Type != "") {
+			apis++
+		}
+		if change.Impact == "breaking" {
+			breaking++
+		}
+	}
+	return apis, breaking
+}
+
+// comparisonSummary creates the summary line for a comparison
+func comparisonSummary(p sprintf, comparison *domain.VersionComparison) string {
+	return p("Go %s adds %d new APIs, %d language changes and %d breaking changes over Go %s, touching %d packages",
+		comparison.ToVersion,
+		comparison.To.NewAPIs-comparison.From.NewAPIs,
+		comparison.To.LanguageChanges-comparison.From.LanguageChanges,
+		comparison.To.BreakingChanges-comparison.From.BreakingChanges,
+		comparison.FromVersion,
+		len(comparison.Packages))
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultComparisonService_CompareVersions(t *testing.T) {
+	testReleases := []*domain.GoRelease{
+		{
+			Version: "1.21",
+			Changes: []domain.Change{
+				{Category: "language", Description: "min, max and clear", Impact: "new"},
+			},
+			Packages: map[string][]domain.PackageChange{
+				"slices": {
+					{Function: "Sort", Description: "Sort a slice", Impact: "new"},
+					{Function: "Index", Description: "Find an element", Impact: "new"},
+				},
+			},
+		},
+		{
+			Version: "1.22",
+			Changes: []domain.Change{
+				{Category: "language", Description: "Per-iteration loop variables", Impact: "breaking"},
+				{Category: "runtime", Description: "Faster GC", Impact: "performance"},
+			},
+			Packages: map[string][]domain.PackageChange{
+				"slices": {
+					{Function: "Concat", Description: "Concatenate slices", Impact: "new"},
+				},
+				"net/http": {
+					{Function: "ServeMux", Description: "Enhanced routing", Impact: "enhancement"},
+				},
+				"arena": {
+					{Function: "New", Description: "New arena", Impact: "new", Experimental: true},
+				},
+			},
+		},
+		{
+			Version: "1.23",
+			Packages: map[string][]domain.PackageChange{
+				"iter": {
+					{Description: "New package", Impact: "new"},
+					{Type: "Seq", Description: "Iterator type", Impact: "new"},
+				},
+			},
+		},
+	}
+
+	repo := &mockRepository{releases: testReleases}
+	comparator := &mockComparator{}
+	service := NewComparisonService(NewFeatureService(repo, comparator), comparator)
+	ctx := context.Background()
+
+	t.Run("side by side totals", func(t *testing.T) {
+		// Versions may be given newest first
+		comparison, err := service.CompareVersions(ctx, "1.23", "1.21", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if comparison.FromVersion != "1.21" || comparison.ToVersion != "1.23" {
+			t.Errorf("expected 1.21 -> 1.23, got %s -> %s", comparison.FromVersion, comparison.ToVersion)
+		}
+
+		expectedFrom := domain.ComparisonTotals{LanguageChanges: 1, BreakingChanges: 0, NewAPIs: 2, PackagesTouched: 1}
+		if comparison.From != expectedFrom {
+			t.Errorf("expected from totals %+v, got %+v", expectedFrom, comparison.From)
+		}
+
+		expectedTo := domain.ComparisonTotals{LanguageChanges: 2, BreakingChanges: 1, NewAPIs: 4, PackagesTouched: 3}
+		if comparison.To != expectedTo {
+			t.Errorf("expected to totals %+v, got %+v", expectedTo, comparison.To)
+		}
+	})
+
+	t.Run("experimental entries are counted on request", func(t *testing.T) {
+		hidden, err := service.CompareVersions(ctx, "1.21", "1.22", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		included, err := service.CompareVersions(ctx, "1.21", "1.22", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if included.To.NewAPIs != hidden.To.NewAPIs+1 || len(included.Packages) != len(hidden.Packages)+1 {
+			t.Errorf("expected arena.New only with include experimental, got %+v and %+v", hidden, included)
+		}
+	})
+
+	t.Run("packages touched", func(t *testing.T) {
+		comparison, err := service.CompareVersions(ctx, "1.21", "1.23", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []domain.PackageComparison{
+			{Package: "iter", FromNewAPIs: 0, ToNewAPIs: 1, ChangedIn: []string{"1.23"}},
+			{Package: "net/http", FromNewAPIs: 0, ToNewAPIs: 0, ChangedIn: []string{"1.22"}},
+			{Package: "slices", FromNewAPIs: 2, ToNewAPIs: 3, ChangedIn: []string{"1.22"}},
+		}
+		if !slices.EqualFunc(comparison.Packages, expected, func(a, b domain.PackageComparison) bool {
+			return a.Package == b.Package && a.FromNewAPIs == b.FromNewAPIs && a.ToNewAPIs == b.ToNewAPIs && slices.Equal(a.ChangedIn, b.ChangedIn)
+		}) {
+			t.Errorf("expected packages %+v, got %+v", expected, comparison.Packages)
+		}
+	})
+
+	t.Run("same version", func(t *testing.T) {
+		_, err := service.CompareVersions(ctx, "1.22", "1.22", false)
+		if !domain.IsValidationError(err) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := service.CompareVersions(ctx, "1.19", "1.22", false)
+		if !domain.IsNotFoundError(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("repository failures are not reported as not found", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleasesUpToVersion", "release data is reloading", nil)
+		failing := NewComparisonService(NewFeatureService(&failingRepository{mockRepository: repo, err: cause}, comparator), comparator)
+		_, err := failing.CompareVersions(ctx, "1.21", "1.22", false)
+		if domain.IsNotFoundError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+
+	t.Run("not found reported through the feature service", func(t *testing.T) {
+		cause := domain.NewNotFoundError("GetReleasesUpToVersion", "release not found")
+		failing := NewComparisonService(NewFeatureService(&failingRepository{mockRepository: repo, err: cause}, comparator), comparator)
+		_, err := failing.CompareVersions(ctx, "1.21", "1.99", false)
+		if !domain.IsNotFoundError(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
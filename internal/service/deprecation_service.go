@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// DefaultDeprecationService implements DeprecationService
+type DefaultDeprecationService struct {
+	repository domain.ReleaseRepository
+	comparator domain.VersionComparator
+}
+
+// NewDeprecationService creates a new deprecation service
+func NewDeprecationService(repository domain.ReleaseRepository, comparator domain.VersionComparator) domain.DeprecationService {
+	return &DefaultDeprecationService{
+		repository: repository,
+		comparator: comparator,
+	}
+}
+
+// ListDeprecations returns the APIs deprecated in or before the specified version
+func (s *DefaultDeprecationService) ListDeprecations(ctx context.Context, version string, includeExperimental bool) (*domain.DeprecationReport, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Validate input
+	if version == "" {
+		return nil, domain.NewValidationError("ListDeprecations", "version cannot be empty", nil)
+	}
+
+	releases, err := s.repository.GetReleasesUpToVersion(ctx, version)
+	if err != nil {
+		return nil, lookupError("ListDeprecations", "version not found", err).
+			WithContext("version", version)
+	}
+
+	report := &domain.DeprecationReport{
+		Version:      version,
+		Deprecations: collectDeprecations(releases, includeExperimental),
+	}
+	report.Summary = deprecationSummary(fmt.Sprintf, report)
+
+	return report, nil
+}
+
+// LookupDeprecation returns the deprecations matching a symbol
+func (s *DefaultDeprecationService) LookupDeprecation(ctx context.Context, symbol string, includeExperimental bool) (*domain.DeprecationReport, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	symbol = strings.TrimSpace(symbol)
+	if symbol == "" {
+		return nil, domain.NewValidationError("LookupDeprecation", "symbol cannot be empty", nil)
+	}
+
+	releases, err := s.repository.GetAllReleases(ctx)
+	if err != nil {
+		return nil, domain.NewServiceError("LookupDeprecation", "failed to get releases", err)
+	}
+
+	// Process releases from oldest to newest so the earliest deprecation wins
+	releases = slices.Clone(releases)
+	slices.SortFunc(releases, func(a, b *domain.GoRelease) int {
+		return s.comparator.Compare(a.Version, b.Version)
+	})
+
+	report := &domain.DeprecationReport{
+		Symbol:       symbol,
+		Deprecations: make([]domain.DeprecationRecord, 0),
+	}
+	for _, record := range collectDeprecations(releases, includeExperimental) {
+		if matchesDeprecation(record, symbol) {
+			report.Deprecations = append(report.Deprecations, record)
+		}
+	}
+
+	report.Summary = deprecationSummary(fmt.Sprintf, report)
+
+	return report, nil
+}
+
+// collectDeprecations merges catalogue entries with package changes marked as "deprecation"
+// Releases must be ordered from oldest to newest; catalogue entries take precedence over derived ones
+// Catalogue entries are never experimental; derived ones follow the experimental filter of the other services
+func collectDeprecations(releases []*domain.GoRelease, includeExperimental bool) []domain.DeprecationRecord {
+	catalogued := make(map[string]bool)
+	for _, release := range releases {
+		for _, deprecation := range release.Deprecations {
+			catalogued[deprecation.Symbol] = true
+		}
+	}
+
+	records := make([]domain.DeprecationRecord, 0, len(catalogued))
+	seen := make(map[string]bool)
+
+	for _, release := range releases {
+		for _, deprecation := range release.Deprecations {
+			if seen[deprecation.Symbol] {
+				continue
+			}
+			seen[deprecation.Symbol] = true
+
+			pkg, _ := splitSymbol(deprecation.Symbol)
+			records = append(records, domain.DeprecationRecord{
+				Symbol:       deprecation.Symbol,
+				Package:      pkg,
+				DeprecatedIn: release.Version,
+				Replacement:  deprecation.Replacement,
+				Description:  deprecation.Description,
+			})
+		}
+
+		// Derive records from deprecated functions the catalogue does not cover yet
+		// Package changes without a function describe behavior rather than a symbol and are skipped
+		for _, pkg := range slices.Sorted(maps.Keys(release.Packages)) {
+			for _, change := range filterPackageChanges(release.Packages[pkg], includeExperimental) {
+				if change.Impact != "deprecation" || change.Function == "" {
+					continue
+				}
+
+				symbol := pkg + "." + change.Function
+				if catalogued[symbol] || seen[symbol] {
+					continue
+				}
+				seen[symbol] = true
+
+				records = append(records, domain.DeprecationRecord{
+					Symbol:       symbol,
+					Package:      pkg,
+					DeprecatedIn: release.Version,
+					Description:  change.Description,
+				})
+			}
+		}
+	}
+
+	return records
+}
+
+// splitSymbol splits "io/ioutil.ReadAll" into "io/ioutil" and "ReadAll"
+func splitSymbol(symbol string) (pkg, name string) {
+	slash := strings.LastIndex(symbol, "/")
+	dot := strings.Index(symbol[slash+1:], ".")
+	if dot < 0 {
+		return symbol, ""
+	}
+	return symbol[:slash+1+dot], symbol[slash+1+dot+1:]
+}
+
+// shortSymbol drops the import path prefix ("io/ioutil.ReadAll" -> "ioutil.ReadAll")
+func shortSymbol(symbol string) string {
+	return symbol[strings.LastIndex(symbol, "/")+1:]
+}
+
+// matchesDeprecation reports whether a lookup query refers to the record
+// A package-level deprecation matches every symbol of that package
+func matchesDeprecation(record domain.DeprecationRecord, query string) bool {
+	if record.Symbol == query || shortSymbol(record.Symbol) == query {
+		return true
+	}
+
+	queryPkg, _ := splitSymbol(query)
+	if record.Symbol != record.Package {
+		return false
+	}
+	return record.Package == queryPkg || shortSymbol(record.Package) == queryPkg
+}
+
+// deprecationSummary creates the summary line for a version listing or a symbol lookup
+func deprecationSummary(p sprintf, report *domain.DeprecationReport) string {
+	switch {
+	case report.Symbol == "":
+		return p("%d deprecated APIs to avoid in your Go %s project", len(report.Deprecations), report.Version)
+	case len(report.Deprecations) == 0:
+		return p("No deprecation is recorded for %s", report.Symbol)
+	default:
+		return p("%s is deprecated", report.Symbol)
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultDeprecationService(t *testing.T) {
+	testReleases := []*domain.GoRelease{
+		{
+			Version: "1.16",
+			Deprecations: []domain.Deprecation{
+				{Symbol: "io/ioutil", Replacement: "io and os packages", Description: "Package deprecated"},
+				{Symbol: "io/ioutil.ReadAll", Replacement: "io.ReadAll", Description: "Reads until EOF"},
+			},
+		},
+		{
+			Version: "1.20",
+			Deprecations: []domain.Deprecation{
+				{Symbol: "math/rand.Seed", Replacement: "automatic seeding", Description: "Seeded at startup"},
+			},
+			Packages: map[string][]domain.PackageChange{
+				"reflect": {
+					{Function: "PtrTo", Description: "Use PointerTo", Impact: "deprecation"},
+				},
+				"arena": {
+					{Function: "New", Description: "Arena experiment dropped", Impact: "deprecation", Experimental: true},
+				},
+			},
+		},
+		{
+			Version: "1.24",
+			Packages: map[string][]domain.PackageChange{
+				"math/rand": {
+					{Function: "Seed", Description: "Seed is a no-op", Impact: "deprecation"},
+				},
+			},
+		},
+	}
+
+	repo := &mockRepository{releases: testReleases}
+	service := NewDeprecationService(repo, &mockComparator{})
+	ctx := context.Background()
+
+	t.Run("list up to version", func(t *testing.T) {
+		report, err := service.ListDeprecations(ctx, "1.20", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []string{"io/ioutil", "io/ioutil.ReadAll", "math/rand.Seed", "reflect.PtrTo"}
+		if len(report.Deprecations) != len(expected) {
+			t.Fatalf("expected %d deprecations, got %+v", len(expected), report.Deprecations)
+		}
+		for i, symbol := range expected {
+			if report.Deprecations[i].Symbol != symbol {
+				t.Errorf("deprecation %d: expected %s, got %s", i, symbol, report.Deprecations[i].Symbol)
+			}
+		}
+	})
+
+	t.Run("experimental deprecations on request", func(t *testing.T) {
+		report, err := service.ListDeprecations(ctx, "1.20", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(report.Deprecations) != 5 || report.Deprecations[3].Symbol != "arena.New" {
+			t.Errorf("expected arena.New before reflect.PtrTo, got %+v", report.Deprecations)
+		}
+
+		lookup, err := service.LookupDeprecation(ctx, "arena.New", false)
+		if err != nil || len(lookup.Deprecations) != 0 {
+			t.Errorf("expected experimental deprecations to be hidden by default, got %+v (%v)", lookup, err)
+		}
+	})
+
+	t.Run("catalogue takes precedence over derived entries", func(t *testing.T) {
+		report, err := service.ListDeprecations(ctx, "1.24", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, record := range report.Deprecations {
+			if record.Symbol == "math/rand.Seed" && record.DeprecatedIn != "1.20" {
+				t.Errorf("expected math/rand.Seed deprecated in 1.20, got %s", record.DeprecatedIn)
+			}
+		}
+		if len(report.Deprecations) != 4 {
+			t.Errorf("expected 4 deprecations without duplicates, got %d", len(report.Deprecations))
+		}
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		tests := []struct {
+			symbol   string
+			expected []string
+		}{
+			{symbol: "io/ioutil.ReadAll", expected: []string{"io/ioutil", "io/ioutil.ReadAll"}},
+			{symbol: "ioutil.ReadFile", expected: []string{"io/ioutil"}},
+			{symbol: "rand.Seed", expected: []string{"math/rand.Seed"}},
+			{symbol: "io.ReadAll", expected: nil},
+		}
+
+		for _, tt := range tests {
+			report, err := service.LookupDeprecation(ctx, tt.symbol, false)
+			if err != nil {
+				t.Fatalf("unexpected error for %s: %v", tt.symbol, err)
+			}
+
+			var got []string
+			for _, record := range report.Deprecations {
+				got = append(got, record.Symbol)
+			}
+			if len(got) != len(tt.expected) {
+				t.Errorf("LookupDeprecation(%s) = %v, want %v", tt.symbol, got, tt.expected)
+				continue
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("LookupDeprecation(%s) = %v, want %v", tt.symbol, got, tt.expected)
+				}
+			}
+		}
+	})
+
+	t.Run("repository failures are not reported as not found", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleasesUpToVersion", "release data is reloading", nil)
+		failing := NewDeprecationService(&failingRepository{mockRepository: repo, err: cause}, &mockComparator{})
+		_, err := failing.ListDeprecations(ctx, "1.20", false)
+		if domain.IsNotFoundError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+}
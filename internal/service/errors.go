@@ -0,0 +1,28 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// lookupError reports a failed repository lookup of operation
+// Only a NotFound from the repository becomes a NotFound with notFound as message; other failures are service errors keeping the cause
+func lookupError(operation, notFound string, err error) *domain.ApplicationError {
+	if reportsNotFound(err) {
+		return domain.NewNotFoundError(operation, notFound)
+	}
+	return domain.NewServiceError(operation, "failed to look up release data", err)
+}
+
+// reportsNotFound reports whether any error in the chain of err is a NotFound
+// Services wrap repository errors, so a NotFound may sit below a service error
+func reportsNotFound(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		var appErr *domain.ApplicationError
+		if errors.As(err, &appErr) && appErr.Type == domain.ErrTypeNotFound {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestLookupError(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		err := lookupError("GetMigrationGuide", "to version not found", domain.NewNotFoundError("GetReleasesUpToVersion", "release not found"))
+		if !domain.IsNotFoundError(err) || err.Message != "to version not found" {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("other failures keep the cause", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleasesUpToVersion", "release data is reloading", nil)
+		err := lookupError("GetMigrationGuide", "to version not found", cause)
+		if !domain.IsServiceError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+
+	t.Run("not found below a service error", func(t *testing.T) {
+		cause := domain.NewServiceError("QueryFeatures", "failed to get releases up to version", domain.NewNotFoundError("GetReleasesUpToVersion", "release not found"))
+		if err := lookupError("CompareVersions", "version not found", cause); !domain.IsNotFoundError(err) {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"html"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// textFormatter renders a feature listing as plain text without markup, for terminals and logs
+type textFormatter struct {
+	comparator domain.VersionComparator
+	options    []ResponseFormatterOption
+}
+
+// Format implements domain.FeatureFormatter
+func (f *textFormatter) Format(response *domain.FeatureResponse, opts domain.FormatOptions) (string, error) {
+	r := formatterFor(f.comparator, f.options, opts)
+	if isEmptyFeatureResponse(response) {
+		return r.t("No Go Features Found") + "\n\n" + r.tf("No Go features found for your project (Go %s).", response.ToVersion) + "\n", nil
+	}
+
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	builder.WriteString(r.tf("Go Features Available (Go %s)", response.ToVersion) + "\n\n")
+	summary := r.serviceText(response.Summary, func(p sprintf) string { return featureSummary(p, response, opts.Package) })
+	builder.WriteString(r.t("Summary") + ": " + summary + "\n")
+
+	for _, version := range r.featureVersions(response) {
+		changes := response.VersionChanges[version]
+		packages := filterPackages(response.VersionPackages[version], opts.Package)
+		if len(changes) == 0 && len(packages) == 0 {
+			continue
+		}
+
+		builder.WriteString("\n" + r.tf("Go %s Features", version) + "\n")
+		if len(changes) > 0 {
+			builder.WriteString("\n  " + r.t("Language & Runtime Changes") + "\n")
+			for _, change := range changes {
+				builder.WriteString("  - " + change.Category + " (" + change.Impact + "): ")
+				builder.WriteString(r.localize(change.Description, change.DescriptionTranslations))
+				builder.WriteString(r.plainLabels(change.Experimental, change.GoExperiment, change.StabilizedIn, change.Verified, change.Confidence))
+				builder.WriteString("\n")
+			}
+		}
+		if len(packages) > 0 {
+			builder.WriteString("\n  " + r.t("Standard Library Updates") + "\n")
+			for _, pkg := range slices.Sorted(maps.Keys(packages)) {
+				for _, change := range packages[pkg] {
+					builder.WriteString("  - " + apiName(pkg, change) + " (" + change.Impact + "): ")
+					builder.WriteString(r.localize(change.Description, change.DescriptionTranslations))
+					builder.WriteString(r.plainLabels(change.Experimental, change.GoExperiment, change.StabilizedIn, change.Verified, change.Confidence))
+					builder.WriteString("\n")
+					if change.Example != "" && r.examples {
+						writeIndented(&builder, change.Example, "      ")
+					}
+				}
+			}
+		}
+	}
+
+	if len(response.Upcoming) > 0 {
+		builder.WriteString("\n" + r.t("Available If You Upgrade") + "\n")
+		builder.WriteString(r.tf("Not available in Go %s. Do not use these features unless the project raises its go directive to the listed version.", response.ToVersion) + "\n")
+		for _, release := range response.Upcoming {
+			builder.WriteString("\n  " + r.tf("Upgrade to Go %s", release.Version) + ": " + release.Justification + "\n")
+			for _, line := range r.upcomingLines(release) {
+				builder.WriteString("  - " + line + "\n")
+			}
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// htmlFormatter renders a feature listing as an HTML fragment for web views
+type htmlFormatter struct {
+	comparator domain.VersionComparator
+	options    []ResponseFormatterOption
+}
+
+// Format implements domain.FeatureFormatter
+func (f *htmlFormatter) Format(response *domain.FeatureResponse, opts domain.FormatOptions) (string, error) {
+	r := formatterFor(f.comparator, f.options, opts)
+	if isEmptyFeatureResponse(response) {
+		return "<h1>" + html.EscapeString(r.t("No Go Features Found")) + "</h1>\n<p>" +
+			html.EscapeString(r.tf("No Go features found for your project (Go %s).", response.ToVersion)) + "</p>\n", nil
+	}
+
+	var builder strings.Builder
+	builder.Grow(4096)
+
+	builder.WriteString("<h1>" + html.EscapeString(r.tf("Go Features Available (Go %s)", response.ToVersion)) + "</h1>\n")
+	builder.WriteString("<h2>" + html.EscapeString(r.t("Summary")) + "</h2>\n")
+	summary := r.serviceText(response.Summary, func(p sprintf) string { return featureSummary(p, response, opts.Package) })
+	builder.WriteString("<p>" + html.EscapeString(summary) + "</p>\n")
+
+	for _, version := range r.featureVersions(response) {
+		changes := response.VersionChanges[version]
+		packages := filterPackages(response.VersionPackages[version], opts.Package)
+		if len(changes) == 0 && len(packages) == 0 {
+			continue
+		}
+
+		builder.WriteString("<h2>" + html.EscapeString(r.tf("Go %s Features", version)) + "</h2>\n")
+		if len(changes) > 0 {
+			builder.WriteString("<h3>" + html.EscapeString(r.t("Language & Runtime Changes")) + "</h3>\n<ul>\n")
+			for _, change := range changes {
+				builder.WriteString("<li><strong>" + html.EscapeString(change.Category) + "</strong> (" + html.EscapeString(change.Impact) + "): ")
+				builder.WriteString(html.EscapeString(r.localize(change.Description, change.DescriptionTranslations)))
+				r.writeHTMLLabels(&builder, change.Experimental, change.GoExperiment, change.StabilizedIn, change.Verified, change.Confidence)
+				builder.WriteString("</li>\n")
+			}
+			builder.WriteString("</ul>\n")
+		}
+		if len(packages) > 0 {
+			builder.WriteString("<h3>" + html.EscapeString(r.t("Standard Library Updates")) + "</h3>\n")
+			for _, pkg := range slices.Sorted(maps.Keys(packages)) {
+				builder.WriteString("<h4>" + html.EscapeString(r.t("Package")) + " <code>" + html.EscapeString(pkg) + "</code></h4>\n<ul>\n")
+				for _, change := range packages[pkg] {
+					builder.WriteString("<li><code>" + html.EscapeString(apiName(pkg, change)) + "</code> (" + html.EscapeString(change.Impact) + "): ")
+					builder.WriteString(html.EscapeString(r.localize(change.Description, change.DescriptionTranslations)))
+					r.writeHTMLLabels(&builder, change.Experimental, change.GoExperiment, change.StabilizedIn, change.Verified, change.Confidence)
+					if change.Example != "" && r.examples {
+						builder.WriteString("\n<pre><code class=\"language-go\">" + html.EscapeString(change.Example) + "</code></pre>\n")
+					}
+					builder.WriteString("</li>\n")
+				}
+				builder.WriteString("</ul>\n")
+			}
+		}
+	}
+
+	if len(response.Upcoming) > 0 {
+		builder.WriteString("<h2>" + html.EscapeString(r.t("Available If You Upgrade")) + "</h2>\n")
+		builder.WriteString("<p><strong>" + html.EscapeString(r.tf("Not available in Go %s. Do not use these features unless the project raises its go directive to the listed version.", response.ToVersion)) + "</strong></p>\n")
+		for _, release := range response.Upcoming {
+			builder.WriteString("<h3>" + html.EscapeString(r.tf("Upgrade to Go %s", release.Version)) + "</h3>\n")
+			builder.WriteString("<p><em>" + html.EscapeString(r.t("Why:")) + "</em> " + html.EscapeString(release.Justification) + "</p>\n<ul>\n")
+			for _, line := range r.upcomingLines(release) {
+				builder.WriteString("<li>" + html.EscapeString(line) + "</li>\n")
+			}
+			builder.WriteString("</ul>\n")
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// llmFormatter renders one terse line per change, prefixed with the release that introduced it
+// It drops headings, summaries and labels other than experimental to fit small context windows
+type llmFormatter struct {
+	comparator domain.VersionComparator
+	options    []ResponseFormatterOption
+}
+
+// Format implements domain.FeatureFormatter
+func (f *llmFormatter) Format(response *domain.FeatureResponse, opts domain.FormatOptions) (string, error) {
+	r := formatterFor(f.comparator, f.options, opts)
+	if isEmptyFeatureResponse(response) {
+		return r.tf("No Go features found for your project (Go %s).", response.ToVersion) + "\n", nil
+	}
+
+	var builder strings.Builder
+	builder.Grow(1024)
+
+	builder.WriteString(r.tf("Go Features Available (Go %s)", response.ToVersion) + "\n")
+	for _, version := range r.featureVersions(response) {
+		for _, change := range response.VersionChanges[version] {
+			builder.WriteString(version + " " + change.Category + " (" + change.Impact + "): ")
+			builder.WriteString(r.localize(change.Description, change.DescriptionTranslations))
+			builder.WriteString(r.plainLabels(change.Experimental, change.GoExperiment, change.StabilizedIn, true, ""))
+			builder.WriteString("\n")
+		}
+
+		packages := filterPackages(response.VersionPackages[version], opts.Package)
+		for _, pkg := range slices.Sorted(maps.Keys(packages)) {
+			for _, change := range packages[pkg] {
+				builder.WriteString(version + " " + apiName(pkg, change) + " (" + change.Impact + "): ")
+				builder.WriteString(r.localize(change.Description, change.DescriptionTranslations))
+				builder.WriteString(r.plainLabels(change.Experimental, change.GoExperiment, change.StabilizedIn, true, ""))
+				builder.WriteString("\n")
+				if change.Example != "" && r.examples {
+					writeIndented(&builder, change.Example, "  ")
+				}
+			}
+		}
+	}
+
+	for _, release := range response.Upcoming {
+		for _, line := range r.upcomingLines(release) {
+			builder.WriteString(release.Version + " " + line + "\n")
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// isEmptyFeatureResponse reports whether a listing has nothing to show
+func isEmptyFeatureResponse(response *domain.FeatureResponse) bool {
+	return len(response.Changes) == 0 && len(response.PackageInfo) == 0 && len(response.Upcoming) == 0
+}
+
+// featureVersions returns the releases of a listing, oldest first
+func (f *DefaultResponseFormatter) featureVersions(response *domain.FeatureResponse) []string {
+	versions := slices.Collect(maps.Keys(response.VersionChanges))
+	for version := range response.VersionPackages {
+		if !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+	f.sortVersions(versions)
+	return versions
+}
+
+// filterPackages keeps only packageName, or every package when it is empty
+func filterPackages(packages map[string][]domain.PackageChange, packageName string) map[string][]domain.PackageChange {
+	if packageName == "" {
+		return packages
+	}
+	if changes, exists := packages[packageName]; exists {
+		return map[string][]domain.PackageChange{packageName: changes}
+	}
+	return nil
+}
+
+// apiName returns the qualified name of a package change (e.g., "slices.Contains"), or the package alone
+func apiName(pkg string, change domain.PackageChange) string {
+	if change.Function != "" {
+		return pkg + "." + change.Function
+	}
+	return pkg
+}
+
+// upcomingLines returns one line per change of a release after the project version
+func (f *DefaultResponseFormatter) upcomingLines(release domain.UpcomingRelease) []string {
+	var lines []string
+	for _, change := range release.Changes {
+		lines = append(lines, change.Category+" ("+change.Impact+f.tf(", requires Go %s", release.Version)+"): "+
+			f.localize(change.Description, change.DescriptionTranslations)+
+			f.plainLabels(change.Experimental, change.GoExperiment, change.StabilizedIn, change.Verified, change.Confidence))
+	}
+	for _, pkg := range slices.Sorted(maps.Keys(release.Packages)) {
+		for _, change := range release.Packages[pkg] {
+			lines = append(lines, apiName(pkg, change)+" ("+change.Impact+f.tf(", requires Go %s", release.Version)+"): "+
+				f.localize(change.Description, change.DescriptionTranslations)+
+				f.plainLabels(change.Experimental, change.GoExperiment, change.StabilizedIn, change.Verified, change.Confidence))
+		}
+	}
+	return lines
+}
+
+// plainLabels returns the experimental label and unverified badge in parentheses, without markup
+func (f *DefaultResponseFormatter) plainLabels(experimental bool, goExperiment, stabilizedIn string, verified bool, confidence string) string {
+	var labels string
+	if label := f.experimentalLabel(experimental, goExperiment, stabilizedIn); label != "" {
+		labels += " (" + label + ")"
+	}
+	if badge := f.verificationBadge(verified, confidence); badge != "" {
+		labels += " (" + badge + ")"
+	}
+	return labels
+}
+
+// writeHTMLLabels writes the experimental label and unverified badge as emphasized text
+func (f *DefaultResponseFormatter) writeHTMLLabels(builder *strings.Builder, experimental bool, goExperiment, stabilizedIn string, verified bool, confidence string) {
+	if label := f.experimentalLabel(experimental, goExperiment, stabilizedIn); label != "" {
+		builder.WriteString(" <em>(" + html.EscapeString(label) + ")</em>")
+	}
+	if badge := f.verificationBadge(verified, confidence); badge != "" {
+		builder.WriteString(" <em>(" + html.EscapeString(badge) + ")</em>")
+	}
+}
+
+// writeIndented writes every line of text with a prefix
+func writeIndented(builder *strings.Builder, text, prefix string) {
+	for line := range strings.Lines(text) {
+		builder.WriteString(prefix + strings.TrimRight(line, "\n") + "\n")
+	}
+}
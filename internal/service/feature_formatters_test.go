@@ -0,0 +1,153 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+// newFormatFixture returns a listing with a language change, a package change with an example and an upcoming release
+func newFormatFixture() *domain.FeatureResponse {
+	contains := domain.PackageChange{Function: "Contains", Description: "reports whether v is present", Impact: "new", Example: "if slices.Contains(s, v) {\n\treturn\n}", Verification: domain.Verification{Verified: true}}
+	rangefunc := domain.Change{Category: "language", Description: "range over functions", Impact: "new", Experimental: true, GoExperiment: "rangefunc", Verification: domain.Verification{Verified: true}}
+	return &domain.FeatureResponse{
+		ToVersion:   "1.22",
+		Summary:     "Fixture <listing>",
+		Changes:     []domain.Change{rangefunc},
+		PackageInfo: map[string][]domain.PackageChange{"slices": {contains}},
+		VersionChanges: map[string][]domain.Change{
+			"1.21": {},
+			"1.22": {rangefunc},
+		},
+		VersionPackages: map[string]map[string][]domain.PackageChange{
+			"1.21": {"slices": {contains}},
+		},
+		Upcoming: []domain.UpcomingRelease{{
+			Version:       "1.23",
+			Justification: "iterators",
+			Packages:      map[string][]domain.PackageChange{"iter": {{Function: "Seq", Description: "iterator type", Impact: "new"}}},
+		}},
+	}
+}
+
+func TestTextFormatter(t *testing.T) {
+	formatter := &textFormatter{comparator: version.NewSemanticVersionComparator()}
+
+	result, err := formatter.Format(newFormatFixture(), domain.FormatOptions{Version: "1.22", IncludeExamples: true})
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	expected := `Go Features Available (Go 1.22)
+
+Summary: Fixture <listing>
+
+Go 1.21 Features
+
+  Standard Library Updates
+  - slices.Contains (new): reports whether v is present
+      if slices.Contains(s, v) {
+      	return
+      }
+
+Go 1.22 Features
+
+  Language & Runtime Changes
+  - language (new): range over functions (experimental; requires GOEXPERIMENT=rangefunc)
+
+Available If You Upgrade
+Not available in Go 1.22. Do not use these features unless the project raises its go directive to the listed version.
+
+  Upgrade to Go 1.23: iterators
+  - iter.Seq (new, requires Go 1.23): iterator type
+`
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestHTMLFormatter(t *testing.T) {
+	formatter := &htmlFormatter{comparator: version.NewSemanticVersionComparator()}
+
+	result, err := formatter.Format(newFormatFixture(), domain.FormatOptions{Version: "1.22", Package: "slices", IncludeExamples: true})
+	if err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	expected := `<h1>Go Features Available (Go 1.22)</h1>
+<h2>Summary</h2>
+<p>Fixture &lt;listing&gt;</p>
+<h2>Go 1.21 Features</h2>
+<h3>Standard Library Updates</h3>
+<h4>Package <code>slices</code></h4>
+<ul>
+<li><code>slices.Contains</code> (new): reports whether v is present
+<pre><code class="language-go">if slices.Contains(s, v) {
+	return
+}</code></pre>
+</li>
+</ul>
+<h2>Go 1.22 Features</h2>
+<h3>Language &amp; Runtime Changes</h3>
+<ul>
+<li><strong>language</strong> (new): range over functions <em>(experimental; requires GOEXPERIMENT=rangefunc)</em></li>
+</ul>
+<h2>Available If You Upgrade</h2>
+<p><strong>Not available in Go 1.22. Do not use these features unless the project raises its go directive to the listed version.</strong></p>
+<h3>Upgrade to Go 1.23</h3>
+<p><em>Why:</em> iterators</p>
+<ul>
+<li>iter.Seq (new, requires Go 1.23): iterator type</li>
+</ul>
+`
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestLLMFormatter(t *testing.T) {
+	formatter := &llmFormatter{comparator: version.NewSemanticVersionComparator()}
+
+	t.Run("without examples", func(t *testing.T) {
+		result, err := formatter.Format(newFormatFixture(), domain.FormatOptions{Version: "1.22"})
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+
+		expected := `Go Features Available (Go 1.22)
+1.21 slices.Contains (new): reports whether v is present
+1.22 language (new): range over functions (experimental; requires GOEXPERIMENT=rangefunc)
+1.23 iter.Seq (new, requires Go 1.23): iterator type
+`
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		result, err := formatter.Format(newFormatFixture(), domain.FormatOptions{Version: "1.22", Locale: "ja", IncludeExamples: true})
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+
+		expected := `利用可能な Go の機能 (Go 1.22)
+1.21 slices.Contains (new): reports whether v is present
+  if slices.Contains(s, v) {
+  	return
+  }
+1.22 language (new): range over functions (実験的機能; GOEXPERIMENT=rangefunc が必要)
+1.23 iter.Seq (new, Go 1.23 以降が必要): iterator type
+`
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		result, _ := formatter.Format(&domain.FeatureResponse{ToVersion: "1.21"}, domain.FormatOptions{Version: "1.21"})
+		if expected := "No Go features found for your project (Go 1.21).\n"; result != expected {
+			t.Errorf("Expected %q, got %q", expected, result)
+		}
+	})
+}
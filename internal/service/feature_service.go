@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strconv"
 
@@ -24,6 +25,11 @@ func NewFeatureService(repository domain.ReleaseRepository, comparator domain.Ve
 
 // GetFeaturesForVersion returns all features available from the oldest version up to the specified version
 func (s *DefaultFeatureService) GetFeaturesForVersion(ctx context.Context, targetVersion string, packageName string) (*domain.FeatureResponse, error) {
+	return s.QueryFeatures(ctx, domain.FeatureQuery{Version: targetVersion, Package: packageName})
+}
+
+// QueryFeatures returns all features available up to the queried version and, on request, those of later releases
+func (s *DefaultFeatureService) QueryFeatures(ctx context.Context, query domain.FeatureQuery) (*domain.FeatureResponse, error) {
 	// Check context cancellation
 	select {
 	case <-ctx.Done():
@@ -31,27 +37,29 @@ func (s *DefaultFeatureService) GetFeaturesForVersion(ctx context.Context, targe
 	default:
 	}
 
+	targetVersion, packageName := query.Version, query.Package
+
 	// Validate input
 	if targetVersion == "" {
-		return nil, domain.NewValidationError("GetFeaturesForVersion", "target version cannot be empty", nil)
+		return nil, domain.NewValidationError("QueryFeatures", "target version cannot be empty", nil)
 	}
 
 	// Get releases up to target version
 	availableReleases, err := s.repository.GetReleasesUpToVersion(ctx, targetVersion)
 	if err != nil {
-		return nil, domain.NewServiceError("GetFeaturesForVersion", "failed to get releases up to version", err).
+		return nil, domain.NewServiceError("QueryFeatures", "failed to get releases up to version", err).
 			WithContext("targetVersion", targetVersion)
 	}
 
 	if len(availableReleases) == 0 {
-		return nil, domain.NewNotFoundError("GetFeaturesForVersion", "no releases found up to version").
+		return nil, domain.NewNotFoundError("QueryFeatures", "no releases found up to version").
 			WithContext("targetVersion", targetVersion)
 	}
 
 	// Find oldest version
 	oldestVersion, err := s.repository.GetOldestVersion(ctx)
 	if err != nil {
-		return nil, domain.NewServiceError("GetFeaturesForVersion", "failed to get oldest version", err)
+		return nil, domain.NewServiceError("QueryFeatures", "failed to get oldest version", err)
 	}
 
 	// Build response
@@ -68,7 +76,7 @@ func (s *DefaultFeatureService) GetFeaturesForVersion(ctx context.Context, targe
 
 	for _, release := range availableReleases {
 		// Group changes by version using slices.Clone for safety
-		allChanges[release.Version] = slices.Clone(release.Changes)
+		allChanges[release.Version] = filterChanges(release.Changes, query.IncludeExperimental)
 
 		// Group package changes by version
 		allPackageInfo[release.Version] = make(map[string][]domain.PackageChange)
@@ -76,12 +84,16 @@ func (s *DefaultFeatureService) GetFeaturesForVersion(ctx context.Context, targe
 		if packageName != "" {
 			// Filter for specific package
 			if pkgChanges, exists := release.Packages[packageName]; exists {
-				allPackageInfo[release.Version][packageName] = slices.Clone(pkgChanges)
+				if filtered := filterPackageChanges(pkgChanges, query.IncludeExperimental); len(filtered) > 0 {
+					allPackageInfo[release.Version][packageName] = filtered
+				}
 			}
 		} else {
 			// Include all packages using maps.Copy for efficiency
 			for pkg, changes := range release.Packages {
-				allPackageInfo[release.Version][pkg] = slices.Clone(changes)
+				if filtered := filterPackageChanges(changes, query.IncludeExperimental); len(filtered) > 0 {
+					allPackageInfo[release.Version][pkg] = filtered
+				}
 			}
 		}
 	}
@@ -114,35 +126,124 @@ func (s *DefaultFeatureService) GetFeaturesForVersion(ctx context.Context, targe
 	response.VersionChanges = allChanges
 	response.VersionPackages = allPackageInfo
 
-	// Generate summary using modern string formatting
-	summary := s.generateSummary(targetVersion, oldestVersion, packageName, response)
-	response.Summary = summary
+	response.Summary = FeatureSummary(response, packageName)
+
+	if query.IncludeUpcoming {
+		upcoming, err := s.upcomingReleases(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		response.Upcoming = upcoming
+	}
 
 	return response, nil
 }
 
-// generateSummary creates an appropriate summary message
-func (s *DefaultFeatureService) generateSummary(targetVersion, oldestVersion, packageName string, response *domain.FeatureResponse) string {
+// upcomingReleases collects the features of releases after targetVersion, skipping releases with nothing relevant
+func (s *DefaultFeatureService) upcomingReleases(ctx context.Context, query domain.FeatureQuery) ([]domain.UpcomingRelease, error) {
+	targetVersion, packageName := query.Version, query.Package
+
+	releases, err := s.repository.GetReleasesAfterVersion(ctx, targetVersion)
+	if err != nil {
+		return nil, domain.NewServiceError("QueryFeatures", "failed to get releases after version", err).
+			WithContext("targetVersion", targetVersion)
+	}
+
+	upcoming := make([]domain.UpcomingRelease, 0, len(releases))
+	for _, release := range releases {
+		entry := domain.UpcomingRelease{
+			Version:  release.Version,
+			Changes:  make([]domain.Change, 0),
+			Packages: make(map[string][]domain.PackageChange),
+		}
+
+		// General changes are only relevant without a package filter, like in the formatted output
+		if packageName == "" {
+			entry.Changes = filterChanges(release.Changes, query.IncludeExperimental)
+		}
+		for pkg, changes := range release.Packages {
+			if packageName != "" && pkg != packageName {
+				continue
+			}
+			if filtered := filterPackageChanges(changes, query.IncludeExperimental); len(filtered) > 0 {
+				entry.Packages[pkg] = filtered
+			}
+		}
+
+		if len(entry.Changes) == 0 && len(entry.Packages) == 0 {
+			continue
+		}
+
+		entry.Justification = upgradeJustification(release, packageName, entry)
+		upcoming = append(upcoming, entry)
+	}
+
+	return upcoming, nil
+}
+
+// upgradeJustification creates a short reason to upgrade to an upcoming release
+func upgradeJustification(release *domain.GoRelease, packageName string, entry domain.UpcomingRelease) string {
+	apis := 0
+	languageChanges := 0
+	for _, changes := range entry.Packages {
+		newAPIs, _ := countPackageChanges(changes)
+		apis += newAPIs
+	}
+	for _, change := range entry.Changes {
+		if change.Category == "language" {
+			languageChanges++
+		}
+	}
+
+	if packageName != "" {
+		return "Adds " + strconv.Itoa(len(entry.Packages[packageName])) + " changes to package '" + packageName + "', including " + strconv.Itoa(apis) + " new APIs"
+	}
+	return release.Summary + " (" + strconv.Itoa(languageChanges) + " language changes, " + strconv.Itoa(apis) + " new APIs)"
+}
+
+// filterChanges returns a copy of changes without experimental entries, unless the query opts into them
+// Unverified entries are already dropped by the repository in verified-only mode
+func filterChanges(changes []domain.Change, includeExperimental bool) []domain.Change {
+	return slices.DeleteFunc(slices.Clone(changes), func(change domain.Change) bool {
+		return change.Experimental && !includeExperimental
+	})
+}
+
+// filterPackageChanges returns a copy of changes without experimental entries, unless the query opts into them
+// It is shared by every service listing package changes so they hide the same entries
+func filterPackageChanges(changes []domain.PackageChange, includeExperimental bool) []domain.PackageChange {
+	return slices.DeleteFunc(slices.Clone(changes), func(change domain.PackageChange) bool {
+		return change.Experimental && !includeExperimental
+	})
+}
+
+// FeatureSummary returns the English summary line of a feature response, including search results
+func FeatureSummary(response *domain.FeatureResponse, packageName string) string {
+	return featureSummary(fmt.Sprintf, response, packageName)
+}
+
+// featureSummary creates the summary line of a response listing features up to ToVersion
+func featureSummary(p sprintf, response *domain.FeatureResponse, packageName string) string {
 	totalChanges := len(response.Changes)
 	totalPackages := len(response.PackageInfo)
 
+	if response.SearchTerm != "" {
+		total := totalChanges
+		for _, changes := range response.PackageInfo {
+			total += len(changes)
+		}
+		return p("Found %d changes mentioning '%s' in Go %s through %s", total, response.SearchTerm, response.FromVersion, response.ToVersion)
+	}
+
 	if packageName != "" {
 		if totalPackages > 0 {
-			return "Features available for package '" + packageName + "' in your Go " + targetVersion + " project (from Go " + oldestVersion + ")"
+			return p("Features available for package '%s' in your Go %s project (from Go %s)", packageName, response.ToVersion, response.FromVersion)
 		}
-		return "No features found for package '" + packageName + "' in your Go " + targetVersion + " project"
+		return p("No features found for package '%s' in your Go %s project", packageName, response.ToVersion)
 	}
 
-	// Use more efficient string building for complex formatting
 	if totalChanges == 0 && totalPackages == 0 {
-		return "No Go features found in your Go " + targetVersion + " project"
+		return p("No Go features found in your Go %s project", response.ToVersion)
 	}
-
-	// Build summary with available data
-	summary := "All Go features available in your project (Go " + targetVersion + ")"
-	if totalChanges > 0 || totalPackages > 0 {
-		summary += ": " + strconv.Itoa(totalChanges) + " changes across " + strconv.Itoa(totalPackages) + " packages from Go " + oldestVersion
-	}
-
-	return summary
+	return p("All Go features available in your project (Go %s): %d changes across %d packages from Go %s", response.ToVersion, totalChanges, totalPackages, response.FromVersion)
 }
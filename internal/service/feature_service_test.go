@@ -24,7 +24,8 @@ func (m *mockRepository) GetReleaseByVersion(ctx context.Context, version string
 			return release, nil
 		}
 	}
-	return nil, fmt.Errorf("version not found: %s", version)
+	return nil, domain.NewNotFoundError("GetReleaseByVersion", "release not found").
+		WithContext("version", version)
 }
 
 func (m *mockRepository) GetReleasesUpToVersion(ctx context.Context, targetVersion string) ([]*domain.GoRelease, error) {
@@ -37,6 +38,47 @@ func (m *mockRepository) GetReleasesUpToVersion(ctx context.Context, targetVersi
 	return result, nil
 }
 
+func (m *mockRepository) GetReleasesAfterVersion(ctx context.Context, version string) ([]*domain.GoRelease, error) {
+	var result []*domain.GoRelease
+	for _, release := range m.releases {
+		if release.Version > version { // Simple string comparison for testing
+			result = append(result, release)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepository) GetPackageVersions(ctx context.Context, packageName string) ([]string, error) {
+	var result []string
+	for _, release := range m.releases {
+		if _, exists := release.Packages[packageName]; exists {
+			result = append(result, release.Version)
+		}
+	}
+	if len(result) == 0 {
+		return nil, domain.NewNotFoundError("GetPackageVersions", "package not found").
+			WithContext("package", packageName)
+	}
+	return result, nil
+}
+
+func (m *mockRepository) GetPackagesUpToVersion(ctx context.Context, targetVersion string) ([]string, error) {
+	seen := make(map[string]bool)
+	var result []string
+	for _, release := range m.releases {
+		if release.Version > targetVersion { // Simple string comparison for testing
+			continue
+		}
+		for pkg := range release.Packages {
+			if !seen[pkg] {
+				seen[pkg] = true
+				result = append(result, pkg)
+			}
+		}
+	}
+	return result, nil
+}
+
 func (m *mockRepository) GetOldestVersion(ctx context.Context) (string, error) {
 	if len(m.releases) == 0 {
 		return "", fmt.Errorf("no releases")
@@ -51,6 +93,20 @@ func (m *mockRepository) GetLatestVersion(ctx context.Context) (string, error) {
 	return m.releases[len(m.releases)-1].Version, nil
 }
 
+// failingRepository fails every release lookup of the wrapped repository with err, e.g. while data is reloading
+type failingRepository struct {
+	*mockRepository
+	err error
+}
+
+func (m *failingRepository) GetReleaseByVersion(ctx context.Context, version string) (*domain.GoRelease, error) {
+	return nil, m.err
+}
+
+func (m *failingRepository) GetReleasesUpToVersion(ctx context.Context, targetVersion string) ([]*domain.GoRelease, error) {
+	return nil, m.err
+}
+
 // mockComparator implements domain.VersionComparator for testing
 type mockComparator struct{}
 
@@ -150,4 +206,74 @@ func TestDefaultFeatureService_GetFeaturesForVersion(t *testing.T) {
 			t.Error("expected net/http package in response")
 		}
 	})
+	t.Run("include upcoming", func(t *testing.T) {
+		ctx := context.Background()
+		response, err := service.QueryFeatures(ctx, domain.FeatureQuery{Version: "1.21", IncludeUpcoming: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(response.Changes) != 1 {
+			t.Errorf("expected upcoming changes to stay out of the usable changes, got %d", len(response.Changes))
+		}
+		if len(response.Upcoming) != 1 || response.Upcoming[0].Version != "1.22" {
+			t.Fatalf("expected 1.22 as the only upcoming release, got %+v", response.Upcoming)
+		}
+
+		upcoming := response.Upcoming[0]
+		if len(upcoming.Changes) != 1 || len(upcoming.Packages["net/http"]) != 1 {
+			t.Errorf("expected 1.22 changes and packages, got %+v", upcoming)
+		}
+		if upcoming.Justification != "Go 1.22 release (1 language changes, 0 new APIs)" {
+			t.Errorf("unexpected justification %q", upcoming.Justification)
+		}
+
+		filtered, err := service.QueryFeatures(ctx, domain.FeatureQuery{Version: "1.21", Package: "slices", IncludeUpcoming: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(filtered.Upcoming) != 0 {
+			t.Errorf("expected releases without slices changes to be skipped, got %+v", filtered.Upcoming)
+		}
+
+		plain, _ := service.GetFeaturesForVersion(ctx, "1.21", "")
+		if plain.Upcoming != nil {
+			t.Error("expected no upcoming releases unless requested")
+		}
+	})
+
+	t.Run("experimental entries", func(t *testing.T) {
+		releases := []*domain.GoRelease{
+			{
+				Version: "1.22",
+				Changes: []domain.Change{
+					{Category: "language", Description: "For-range over integers", Impact: "new"},
+					{Category: "language", Description: "Range-over-func preview", Impact: "new", Experimental: true, GoExperiment: "rangefunc", StabilizedIn: "1.23"},
+				},
+				Packages: map[string][]domain.PackageChange{
+					"testing/synctest": {
+						{Function: "Run", Description: "Run in a bubble", Impact: "new", Experimental: true, GoExperiment: "synctest"},
+					},
+				},
+			},
+		}
+		experimentalService := NewFeatureService(&mockRepository{releases: releases}, comparator)
+		ctx := context.Background()
+
+		hidden, err := experimentalService.GetFeaturesForVersion(ctx, "1.22", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(hidden.Changes) != 1 || len(hidden.PackageInfo) != 0 {
+			t.Errorf("expected experimental entries to be hidden by default, got %+v and %v", hidden.Changes, hidden.PackageInfo)
+		}
+
+		included, err := experimentalService.QueryFeatures(ctx, domain.FeatureQuery{Version: "1.22", IncludeExperimental: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(included.Changes) != 2 || len(included.PackageInfo["testing/synctest"]) != 1 {
+			t.Errorf("expected experimental entries when opted in, got %+v and %v", included.Changes, included.PackageInfo)
+		}
+	})
 }
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// Output formats of feature listings
+const (
+	FormatMarkdown = "markdown"
+	FormatJSON     = "json"
+	FormatText     = "text"
+	FormatHTML     = "html"
+	// FormatLLM lists one terse line per change for agents with a tight context budget
+	FormatLLM = "llm"
+	// FormatCheatsheet tables older patterns next to their modern replacements, grouped by theme
+	FormatCheatsheet = "cheatsheet"
+)
+
+// FormatterRegistry selects a FeatureFormatter by format name
+// It is safe for concurrent use, so formats can be registered while requests are served
+type FormatterRegistry struct {
+	mu         sync.RWMutex
+	formatters map[string]domain.FeatureFormatter
+	options    []ResponseFormatterOption
+	themes     []domain.CheatsheetTheme
+}
+
+// FormatterRegistryOption configures a FormatterRegistry
+type FormatterRegistryOption func(*FormatterRegistry)
+
+// WithFormatterOptions configures the built-in formats (e.g., WithUnverifiedBadges)
+// Per-request FormatOptions are applied on top of them
+func WithFormatterOptions(opts ...ResponseFormatterOption) FormatterRegistryOption {
+	return func(r *FormatterRegistry) {
+		r.options = append(r.options, opts...)
+	}
+}
+
+// WithCheatsheetThemes sets the themes of the cheatsheet format; without them it lists nothing
+func WithCheatsheetThemes(themes []domain.CheatsheetTheme) FormatterRegistryOption {
+	return func(r *FormatterRegistry) {
+		r.themes = themes
+	}
+}
+
+// NewFormatterRegistry creates a registry holding the built-in formats
+func NewFormatterRegistry(comparator domain.VersionComparator, opts ...FormatterRegistryOption) *FormatterRegistry {
+	r := &FormatterRegistry{formatters: make(map[string]domain.FeatureFormatter)}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.Register(FormatMarkdown, &markdownFormatter{comparator: comparator, options: r.options})
+	r.Register(FormatJSON, jsonFormatter{})
+	r.Register(FormatText, &textFormatter{comparator: comparator, options: r.options})
+	r.Register(FormatHTML, &htmlFormatter{comparator: comparator, options: r.options})
+	r.Register(FormatLLM, &llmFormatter{comparator: comparator, options: r.options})
+	r.Register(FormatCheatsheet, &cheatsheetFormatter{comparator: comparator, options: r.options, themes: r.themes})
+	return r
+}
+
+// Register adds a format or replaces the formatter of an existing one
+func (r *FormatterRegistry) Register(name string, formatter domain.FeatureFormatter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.formatters[name] = formatter
+}
+
+// Lookup returns the formatter registered for name
+func (r *FormatterRegistry) Lookup(name string) (domain.FeatureFormatter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	formatter, exists := r.formatters[name]
+	return formatter, exists
+}
+
+// Names returns the registered format names, sorted
+func (r *FormatterRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.formatters))
+}
+
+// Format renders response in the named format
+func (r *FormatterRegistry) Format(name string, response *domain.FeatureResponse, opts domain.FormatOptions) (string, error) {
+	formatter, exists := r.Lookup(name)
+	if !exists {
+		names := r.Names()
+		return "", domain.NewValidationError("Format",
+			fmt.Sprintf("unsupported format %q (use %s)", name, strings.Join(names, ", ")), nil).
+			WithContext("format", name).
+			WithContext("allowed", names)
+	}
+	return formatter.Format(response, opts)
+}
+
+// formatterFor applies the per-request options on top of the registry-wide ones
+func formatterFor(comparator domain.VersionComparator, base []ResponseFormatterOption, opts domain.FormatOptions) *DefaultResponseFormatter {
+	all := slices.Clone(base)
+	if opts.Locale != "" {
+		all = append(all, WithLocale(opts.Locale))
+	}
+	if opts.DetailLevel != "" {
+		all = append(all, WithDetailLevel(opts.DetailLevel))
+	}
+	all = append(all, WithExamples(opts.IncludeExamples))
+	return newDefaultResponseFormatter(comparator, all...)
+}
+
+// markdownFormatter renders the Markdown listing returned by go-updates
+type markdownFormatter struct {
+	comparator domain.VersionComparator
+	options    []ResponseFormatterOption
+}
+
+// Format implements domain.FeatureFormatter
+func (f *markdownFormatter) Format(response *domain.FeatureResponse, opts domain.FormatOptions) (string, error) {
+	return formatterFor(f.comparator, f.options, opts).FormatAsText(response, opts.Version, opts.Package), nil
+}
+
+// jsonFormatter renders the response as indented JSON for programs; the presentation options do not apply
+type jsonFormatter struct{}
+
+// Format implements domain.FeatureFormatter
+func (jsonFormatter) Format(response *domain.FeatureResponse, _ domain.FormatOptions) (string, error) {
+	data, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return "", domain.NewServiceError("Format", "failed to encode the response as JSON", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+func TestFormatterRegistry(t *testing.T) {
+	comparator := version.NewSemanticVersionComparator()
+
+	t.Run("built-in formats", func(t *testing.T) {
+		registry := NewFormatterRegistry(comparator)
+		expected := []string{FormatCheatsheet, FormatHTML, FormatJSON, FormatLLM, FormatMarkdown, FormatText}
+		if names := registry.Names(); !slices.Equal(names, expected) {
+			t.Errorf("expected %v, got %v", expected, names)
+		}
+	})
+
+	t.Run("markdown matches the response formatter", func(t *testing.T) {
+		registry := NewFormatterRegistry(comparator, WithFormatterOptions(WithUnverifiedBadges()))
+		response := newFormatFixture()
+		opts := domain.FormatOptions{Version: "1.22", Locale: "ja", DetailLevel: domain.DetailBrief}
+
+		result, err := registry.Format(FormatMarkdown, response, opts)
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+		expected := NewResponseFormatter(comparator, WithUnverifiedBadges(), WithLocale("ja"), WithDetailLevel(domain.DetailBrief)).
+			FormatAsText(response, "1.22", "")
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("cheatsheet themes", func(t *testing.T) {
+		registry := NewFormatterRegistry(comparator, WithCheatsheetThemes(newCheatsheetThemes()))
+		result, err := registry.Format(FormatCheatsheet, newCheatsheetFixture(), domain.FormatOptions{Version: "1.22", Package: "errors"})
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+		if expected := "| a multi-error type | `errors.Join` | Go 1.20 |\n"; !strings.HasSuffix(result, expected) {
+			t.Errorf("expected the configured themes, got:\n%s", result)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		registry := NewFormatterRegistry(comparator)
+		result, err := registry.Format(FormatJSON, newFormatFixture(), domain.FormatOptions{})
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+		var decoded domain.FeatureResponse
+		if err := json.Unmarshal([]byte(result), &decoded); err != nil {
+			t.Fatalf("expected valid JSON: %v", err)
+		}
+		if decoded.ToVersion != "1.22" || len(decoded.PackageInfo["slices"]) != 1 {
+			t.Errorf("unexpected decoded response: %+v", decoded)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		registry := NewFormatterRegistry(comparator)
+		_, err := registry.Format("yaml", newFormatFixture(), domain.FormatOptions{})
+
+		var appErr *domain.ApplicationError
+		if !errors.As(err, &appErr) || appErr.Type != domain.ErrTypeValidation {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+		if allowed, _ := appErr.Context["allowed"].([]string); !slices.Equal(allowed, registry.Names()) {
+			t.Errorf("expected the registered formats as allowed values, got %v", appErr.Context["allowed"])
+		}
+	})
+
+	t.Run("custom format", func(t *testing.T) {
+		registry := NewFormatterRegistry(comparator)
+		custom := &countingFormatter{}
+		registry.Register("custom", custom)
+
+		if !slices.Contains(registry.Names(), "custom") {
+			t.Fatalf("expected the custom format to be registered, got %v", registry.Names())
+		}
+
+		response := newFormatFixture()
+		opts := domain.FormatOptions{Version: "1.22"}
+		for range 2 {
+			if result, err := registry.Format("custom", response, opts); err != nil || result != "# Go 1.22" {
+				t.Fatalf("unexpected result %q (%v)", result, err)
+			}
+		}
+		if custom.calls != 2 {
+			t.Errorf("expected every call to reach the formatter, got %d calls", custom.calls)
+		}
+	})
+
+	t.Run("mutated response is formatted again", func(t *testing.T) {
+		registry := NewFormatterRegistry(comparator)
+		response := newFormatFixture()
+		opts := domain.FormatOptions{Version: "1.22"}
+
+		before, _ := registry.Format(FormatLLM, response, opts)
+		response.PackageInfo["slices"][0].Function = "Index"
+		response.VersionPackages["1.21"]["slices"][0].Function = "Index"
+		after, _ := registry.Format(FormatLLM, response, opts)
+		if before == after || !strings.Contains(after, "Index") {
+			t.Errorf("expected the mutated response to be reflected, got %q", after)
+		}
+	})
+}
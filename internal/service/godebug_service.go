@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// DefaultGodebugService implements GodebugService
+type DefaultGodebugService struct {
+	repository domain.ReleaseRepository
+	comparator domain.VersionComparator
+}
+
+// NewGodebugService creates a new GODEBUG service
+func NewGodebugService(repository domain.ReleaseRepository, comparator domain.VersionComparator) domain.GodebugService {
+	return &DefaultGodebugService{
+		repository: repository,
+		comparator: comparator,
+	}
+}
+
+// godebugHistory holds every recorded default of one setting in chronological order
+type godebugHistory struct {
+	versions []string
+	settings []domain.GodebugSetting
+}
+
+// GetGodebugReport returns the effective GODEBUG defaults for goVersion, optionally compared to another version
+func (s *DefaultGodebugService) GetGodebugReport(ctx context.Context, goVersion, compareTo string) (*domain.GodebugReport, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Validate input
+	if goVersion == "" {
+		return nil, domain.NewValidationError("GetGodebugReport", "go version cannot be empty", nil)
+	}
+
+	for _, v := range []string{goVersion, compareTo} {
+		if v == "" {
+			continue
+		}
+		if _, err := s.repository.GetReleaseByVersion(ctx, v); err != nil {
+			return nil, lookupError("GetGodebugReport", "version not found", err).
+				WithContext("version", v)
+		}
+	}
+
+	histories, err := s.loadHistories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.GodebugReport{
+		GoVersion: goVersion,
+		CompareTo: compareTo,
+		Settings:  make([]domain.GodebugValue, 0, len(histories)),
+	}
+
+	names := slices.Sorted(maps.Keys(histories))
+	for _, name := range names {
+		report.Settings = append(report.Settings, s.effectiveValue(name, histories[name], goVersion))
+	}
+
+	if compareTo != "" {
+		for _, name := range names {
+			from := s.effectiveValue(name, histories[name], compareTo)
+			to := s.effectiveValue(name, histories[name], goVersion)
+			if from.Default == to.Default {
+				continue
+			}
+
+			// The change happened in whichever of the two defaults is newer
+			changedIn, description := to.DefaultSince, to.Description
+			if s.comparator.Compare(compareTo, goVersion) > 0 {
+				changedIn, description = from.DefaultSince, from.Description
+			}
+
+			report.Changes = append(report.Changes, domain.GodebugChange{
+				Name:        name,
+				From:        from.Default,
+				To:          to.Default,
+				ChangedIn:   changedIn,
+				Description: description,
+			})
+		}
+	}
+
+	report.Summary = godebugSummary(fmt.Sprintf, report)
+
+	return report, nil
+}
+
+// loadHistories groups the GODEBUG entries of all releases by setting name
+func (s *DefaultGodebugService) loadHistories(ctx context.Context) (map[string]*godebugHistory, error) {
+	releases, err := s.repository.GetAllReleases(ctx)
+	if err != nil {
+		return nil, domain.NewServiceError("GetGodebugReport", "failed to get releases", err)
+	}
+
+	// Process releases from oldest to newest so each history is chronological
+	releases = slices.Clone(releases)
+	slices.SortFunc(releases, func(a, b *domain.GoRelease) int {
+		return s.comparator.Compare(a.Version, b.Version)
+	})
+
+	histories := make(map[string]*godebugHistory)
+	for _, release := range releases {
+		for _, setting := range release.Godebug {
+			history, exists := histories[setting.Name]
+			if !exists {
+				history = &godebugHistory{}
+				histories[setting.Name] = history
+			}
+			history.versions = append(history.versions, release.Version)
+			history.settings = append(history.settings, setting)
+		}
+	}
+
+	return histories, nil
+}
+
+// effectiveValue resolves the default of a setting for a go directive version
+// Settings introduced after goVersion still apply (the toolchain is assumed to be the latest one),
+// using the pre-introduction default
+func (s *DefaultGodebugService) effectiveValue(name string, history *godebugHistory, goVersion string) domain.GodebugValue {
+	first := history.settings[0]
+	value := domain.GodebugValue{
+		Name:         name,
+		Default:      first.Default,
+		IntroducedIn: history.versions[0],
+		Description:  first.Description,
+	}
+	if first.PreviousDefault != "" {
+		value.Default = first.PreviousDefault
+	}
+
+	for i, setting := range history.settings {
+		if s.comparator.Compare(history.versions[i], goVersion) > 0 {
+			break
+		}
+		value.Default = setting.Default
+		value.DefaultSince = history.versions[i]
+		value.Description = setting.Description
+	}
+
+	return value
+}
+
+// godebugSummary creates the summary line for a report
+func godebugSummary(p sprintf, report *domain.GodebugReport) string {
+	if report.CompareTo != "" {
+		return p("%d GODEBUG settings apply to modules declaring go %s; %d defaults differ from go %s", len(report.Settings), report.GoVersion, len(report.Changes), report.CompareTo)
+	}
+	return p("%d GODEBUG settings apply to modules declaring go %s", len(report.Settings), report.GoVersion)
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultGodebugService_GetGodebugReport(t *testing.T) {
+	testReleases := []*domain.GoRelease{
+		{Version: "1.20"},
+		{
+			Version: "1.21",
+			Godebug: []domain.GodebugSetting{
+				{Name: "panicnil", Default: "0", PreviousDefault: "1", Description: "panic(nil) is a run-time error"},
+			},
+		},
+		{
+			Version: "1.22",
+			Godebug: []domain.GodebugSetting{
+				{Name: "gotypesalias", Default: "0", Description: "Alias types are opt-in"},
+			},
+		},
+		{
+			Version: "1.23",
+			Godebug: []domain.GodebugSetting{
+				{Name: "gotypesalias", Default: "1", Description: "Alias types are produced by default"},
+			},
+		},
+	}
+
+	repo := &mockRepository{releases: testReleases}
+	service := NewGodebugService(repo, &mockComparator{})
+	ctx := context.Background()
+
+	t.Run("effective defaults", func(t *testing.T) {
+		report, err := service.GetGodebugReport(ctx, "1.20", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []domain.GodebugValue{
+			{Name: "gotypesalias", Default: "0", IntroducedIn: "1.22", Description: "Alias types are opt-in"},
+			{Name: "panicnil", Default: "1", IntroducedIn: "1.21", Description: "panic(nil) is a run-time error"},
+		}
+		if len(report.Settings) != len(expected) {
+			t.Fatalf("expected %d settings, got %+v", len(expected), report.Settings)
+		}
+		for i := range expected {
+			if report.Settings[i] != expected[i] {
+				t.Errorf("setting %d: expected %+v, got %+v", i, expected[i], report.Settings[i])
+			}
+		}
+	})
+
+	t.Run("changes relative to another version", func(t *testing.T) {
+		report, err := service.GetGodebugReport(ctx, "1.23", "1.20")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []domain.GodebugChange{
+			{Name: "gotypesalias", From: "0", To: "1", ChangedIn: "1.23", Description: "Alias types are produced by default"},
+			{Name: "panicnil", From: "1", To: "0", ChangedIn: "1.21", Description: "panic(nil) is a run-time error"},
+		}
+		if len(report.Changes) != len(expected) {
+			t.Fatalf("expected %d changes, got %+v", len(expected), report.Changes)
+		}
+		for i := range expected {
+			if report.Changes[i] != expected[i] {
+				t.Errorf("change %d: expected %+v, got %+v", i, expected[i], report.Changes[i])
+			}
+		}
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		if _, err := service.GetGodebugReport(ctx, "1.99", ""); !domain.IsNotFoundError(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("repository failures are not reported as not found", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleasesUpToVersion", "release data is reloading", nil)
+		failing := NewGodebugService(&failingRepository{mockRepository: repo, err: cause}, &mockComparator{})
+		_, err := failing.GetGodebugReport(ctx, "1.20", "")
+		if domain.IsNotFoundError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+}
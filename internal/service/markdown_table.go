@@ -0,0 +1,67 @@
+package service
+
+import "strings"
+
+// markdownTable renders rows as a GitHub-flavored Markdown table
+type markdownTable struct {
+	headers    []string
+	rightAlign []bool
+	rows       [][]string
+}
+
+// newMarkdownTable creates a table with the given column headers
+func newMarkdownTable(headers ...string) *markdownTable {
+	return &markdownTable{
+		headers:    headers,
+		rightAlign: make([]bool, len(headers)),
+	}
+}
+
+// alignRight right-aligns the given columns, e.g. for counts
+func (t *markdownTable) alignRight(columns ...int) *markdownTable {
+	for _, column := range columns {
+		t.rightAlign[column] = true
+	}
+	return t
+}
+
+// addRow appends a row; missing cells are left empty and extra cells are dropped
+func (t *markdownTable) addRow(cells ...string) {
+	row := make([]string, len(t.headers))
+	copy(row, cells)
+	t.rows = append(t.rows, row)
+}
+
+// writeTo writes the table followed by a blank line
+func (t *markdownTable) writeTo(builder *strings.Builder) {
+	writeTableRow(builder, t.headers)
+
+	builder.WriteString("|")
+	for _, right := range t.rightAlign {
+		if right {
+			builder.WriteString(" ---: |")
+		} else {
+			builder.WriteString(" --- |")
+		}
+	}
+	builder.WriteString("\n")
+
+	for _, row := range t.rows {
+		writeTableRow(builder, row)
+	}
+	builder.WriteString("\n")
+}
+
+// tableCellReplacer escapes characters that would break a table row
+var tableCellReplacer = strings.NewReplacer("|", "\\|", "\n", " ")
+
+// writeTableRow writes a single table row
+func writeTableRow(builder *strings.Builder, cells []string) {
+	builder.WriteString("|")
+	for _, cell := range cells {
+		builder.WriteString(" ")
+		builder.WriteString(tableCellReplacer.Replace(cell))
+		builder.WriteString(" |")
+	}
+	builder.WriteString("\n")
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownTable(t *testing.T) {
+	table := newMarkdownTable("Package", "Count", "Note").alignRight(1)
+	table.addRow("`slices`", "12", "a | b")
+	table.addRow("`maps`", "3")
+
+	var builder strings.Builder
+	table.writeTo(&builder)
+
+	expected := "| Package | Count | Note |\n" +
+		"| --- | ---: | --- |\n" +
+		"| `slices` | 12 | a \\| b |\n" +
+		"| `maps` | 3 |  |\n" +
+		"\n"
+
+	if builder.String() != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, builder.String())
+	}
+}
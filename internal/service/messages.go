@@ -0,0 +1,204 @@
+package service
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// messageCatalogs translates the formatter's fixed strings, keyed by locale and English text
+// The English text doubles as the message key and as the fallback for missing translations;
+// format strings keep their verbs, using explicit argument indexes when the word order differs
+var messageCatalogs = map[string]map[string]string{
+	"ja": {
+		// Shared sections
+		"Summary":                    "概要",
+		"Note":                       "補足",
+		"Package":                    "パッケージ",
+		"Sources":                    "出典",
+		"Release notes:":             "リリースノート:",
+		"Proposal:":                  "プロポーザル:",
+		"Docs:":                      "ドキュメント:",
+		"experimental":               "実験的機能",
+		"; requires GOEXPERIMENT=%s": "; GOEXPERIMENT=%s が必要",
+		"; stable since Go %s":       "; Go %s で安定化",
+		"unverified":                 "未検証",
+		", %s confidence":            ", 信頼度: %s",
+
+		// Features
+		"No Go Features Found":                           "Go の機能が見つかりません",
+		"No Go features found for your project (Go %s).": "プロジェクト (Go %s) で利用できる Go の機能が見つかりませんでした。",
+		"Go Features Available (Go %s)":                  "利用可能な Go の機能 (Go %s)",
+		"Go %s Features":                                 "Go %s の機能",
+		"Language & Runtime Changes":                     "言語とランタイムの変更",
+		"Standard Library Updates":                       "標準ライブラリの更新",
+		"These are all the Go features available in your project version. Use them to write modern, efficient Go code.": "以上がプロジェクトのバージョンで利用できる Go の機能です。モダンで効率的な Go コードを書くために活用してください。",
+		"Available If You Upgrade": "アップグレードすると利用可能",
+		"**Not available in Go %s.** Do not use these features unless the project raises its go directive to the listed version.": "**Go %s では利用できません。** プロジェクトの go ディレクティブを記載のバージョンに上げるまで、これらの機能は使用しないでください。",
+		"Not available in Go %s. Do not use these features unless the project raises its go directive to the listed version.":     "Go %s では利用できません。プロジェクトの go ディレクティブを記載のバージョンに上げるまで、これらの機能は使用しないでください。",
+		"Upgrade to Go %s":  "Go %s へのアップグレード",
+		"Why:":              "理由:",
+		", requires Go %s":  ", Go %s 以降が必要",
+		"not yet available": "まだ利用できません",
+		"Features available for package '%s' in your Go %s project (from Go %s)":                      "Go %[2]s のプロジェクトでパッケージ '%[1]s' に利用できる機能 (Go %[3]s から)",
+		"No features found for package '%s' in your Go %s project":                                    "Go %[2]s のプロジェクトでパッケージ '%[1]s' に利用できる機能は見つかりませんでした",
+		"No Go features found in your Go %s project":                                                  "Go %s のプロジェクトで利用できる Go の機能は見つかりませんでした",
+		"All Go features available in your project (Go %s): %d changes across %d packages from Go %s": "プロジェクト (Go %[1]s) で利用できるすべての Go の機能: Go %[4]s からの %[2]d 件の変更と %[3]d 個のパッケージ",
+		"Found %d changes mentioning '%s' in Go %s through %s":                                        "Go %[3]s から Go %[4]s までに '%[2]s' を含む変更が %[1]d 件見つかりました",
+
+		// Cheat sheet
+		"Go Cheat Sheet (Go %s)":                                 "Go チートシート (Go %s)",
+		"No cheat sheet entries found for your project (Go %s).": "プロジェクト (Go %s) のチートシート項目は見つかりませんでした。",
+		"Old pattern":        "従来の書き方",
+		"Modern replacement": "モダンな書き方",
+		"Since":              "導入バージョン",
+
+		// Migration guide
+		"No Migration Steps Found":           "移行手順はありません",
+		"Go Migration Guide (Go %s → Go %s)": "Go 移行ガイド (Go %s → Go %s)",
+		"Step %d":                            "手順 %d",
+		"Migration notes":                    "移行メモ",
+		"What to check":                      "確認事項",
+		"Upgrade one version at a time where possible and rerun your tests after each step.":                                              "可能であれば 1 バージョンずつアップグレードし、各手順の後にテストを再実行してください。",
+		"No breaking changes or deprecations between Go %s and Go %s":                                                                     "Go %s から Go %s までの間に破壊的変更や非推奨化はありません",
+		"%d breaking changes and %d deprecations to review when upgrading from Go %s to Go %s":                                            "Go %[3]s から Go %[4]s へのアップグレードで確認が必要な破壊的変更 %[1]d 件と非推奨化 %[2]d 件",
+		"Raise the go directive in go.mod on a branch and rerun the full test suite; look for code relying on the previous semantics.":    "ブランチで go.mod の go ディレクティブを上げてテストスイート全体を再実行し、以前のセマンティクスに依存するコードを探してください。",
+		"Run load and integration tests against the new runtime and compare behavior, memory usage and panics with the previous version.": "新しいランタイムで負荷テストと統合テストを実行し、動作、メモリ使用量、パニックを以前のバージョンと比較してください。",
+		"Update Makefiles, CI pipelines and developer scripts that invoke the affected go command, flag or environment variable.":         "影響を受ける go コマンド、フラグ、環境変数を使う Makefile、CI パイプライン、開発者向けスクリプトを更新してください。",
+		"Verify that every GOOS/GOARCH target and minimum OS version you deploy to is still supported.":                                   "デプロイ先のすべての GOOS/GOARCH と最小 OS バージョンが引き続きサポートされていることを確認してください。",
+		"Benchmark hot paths before and after upgrading.":                                                                                 "アップグレードの前後でホットパスのベンチマークを取ってください。",
+		"Review code affected by this change and rerun your test suite.":                                                                  "この変更の影響を受けるコードを確認し、テストスイートを再実行してください。",
+		"Find uses of %s (go vet and staticcheck SA1019 report deprecated identifiers) and move to the recommended replacement.":          "%s の使用箇所を探し、推奨される代替に移行してください (go vet と staticcheck SA1019 が非推奨の識別子を報告します)。",
+		"Search for uses of %s and rerun the tests that cover them.":                                                                      "%s の使用箇所を検索し、それらをカバーするテストを再実行してください。",
+
+		// GODEBUG
+		"No GODEBUG Settings Found":                                                           "GODEBUG 設定が見つかりません",
+		"No GODEBUG settings are recorded for go %s.":                                         "go %s に記録された GODEBUG 設定はありません。",
+		"GODEBUG Defaults (go %s)":                                                            "GODEBUG のデフォルト値 (go %s)",
+		"Changed Defaults (go %s → go %s)":                                                    "変更されたデフォルト値 (go %s → go %s)",
+		"No GODEBUG defaults differ between these versions.":                                  "これらのバージョン間で GODEBUG のデフォルト値に違いはありません。",
+		"%d GODEBUG settings apply to modules declaring go %s":                                "go %[2]s を宣言するモジュールに適用される GODEBUG 設定 %[1]d 件",
+		"%d GODEBUG settings apply to modules declaring go %s; %d defaults differ from go %s": "go %[2]s を宣言するモジュールに適用される GODEBUG 設定 %[1]d 件。go %[4]s とはデフォルト値が %[3]d 件異なります",
+		"changed in Go %s":                                                                    "Go %s で変更",
+		"Effective Defaults":                                                                  "有効なデフォルト値",
+		"introduced in Go %s":                                                                 "Go %s で導入",
+		", default since Go %s":                                                               ", Go %s からデフォルト",
+		"Override a default with a `//go:debug name=value` directive in the main package, a `godebug` block in go.mod (Go 1.23+), or the GODEBUG environment variable.": "デフォルト値は main パッケージの `//go:debug name=value` ディレクティブ、go.mod の `godebug` ブロック (Go 1.23 以降)、または GODEBUG 環境変数で上書きできます。",
+
+		// Deprecations
+		"Deprecation Lookup: `%s`":                          "非推奨 API の検索: `%s`",
+		"Go Deprecations (Go %s)":                           "Go の非推奨 API (Go %s)",
+		"Deprecated in Go %s":                               "Go %s で非推奨",
+		"%d deprecated APIs to avoid in your Go %s project": "Go %[2]s のプロジェクトで避けるべき非推奨 API %[1]d 件",
+		"No deprecation is recorded for %s":                 "%s の非推奨化は記録されていません",
+		"%s is deprecated":                                  "%s は非推奨です",
+		"Prefer the replacements in new code; go vet and staticcheck (SA1019) report uses of deprecated identifiers.": "新しいコードでは代替 API を使用してください。go vet と staticcheck (SA1019) は非推奨の識別子の使用を報告します。",
+
+		// Platforms
+		"Go Platform Support":     "Go のプラットフォームサポート",
+		"Ports":                   "ポート",
+		" since Go %s":            " (Go %s から)",
+		"Minimum OS Versions":     "最小 OS バージョン",
+		" or later (since Go %s)": " 以降 (Go %s から)",
+		"Port changes and minimum OS versions recorded up to Go %s":                                                                  "Go %s までに記録されたポートの変更と最小 OS バージョン",
+		"%s is not supported until Go %s, which adds it as an experimental port":                                                     "%[1]s は Go %[2]s で実験的なポートとして追加されるまでサポートされていません",
+		"%s is not supported until Go %s":                                                                                            "%[1]s は Go %[2]s で追加されるまでサポートされていません",
+		"No port change is recorded for %s up to Go %s; ports that predate the recorded data are supported unless listed as removed": "Go %[2]s までに %[1]s のポートの変更は記録されていません。記録より前からあるポートは、削除と記載されていない限りサポートされています",
+		"%s is supported in Go %s (since Go %s)":                                                                                     "%s は Go %s でサポートされています (Go %s から)",
+		"%s is not supported in Go %s (removed in Go %s)":                                                                            "%s は Go %s ではサポートされていません (Go %s で削除)",
+		"%s is %s in Go %s (since Go %s)":                                                                                            "Go %[3]s の %[1]s の状態: %[2]s (Go %[4]s から)",
+		"Go %s requires %s %s or later (since Go %s)":                                                                                "Go %[1]s には %[2]s %[3]s 以降が必要です (Go %[4]s から)",
+		"No minimum OS version is recorded for %s up to Go %s":                                                                       "Go %[2]s までに %[1]s の最小 OS バージョンは記録されていません",
+		". ": "。",
+		"Only ports and requirements that changed in the recorded releases are listed; long-standing first-class ports such as linux/amd64 are supported throughout.": "記録されたリリースで変更のあったポートと要件のみを掲載しています。linux/amd64 などの従来からのファーストクラスポートは常にサポートされています。",
+
+		// Toolchain
+		"No Toolchain Changes Found":                     "ツールチェーンの変更は見つかりません",
+		"Go Toolchain Updates (Go %s)":                   "Go ツールチェーンの更新 (Go %s)",
+		" in Go %s":                                      " (Go %s)",
+		"the go command":                                 "go コマンド",
+		"%d toolchain changes for %s available in Go %s": "Go %[3]s で利用できるツールチェーンの変更 %[1]d 件 (対象: %[2]s)",
+		"Everything listed is available in your Go version; entries marked removed or deprecated should no longer be used in scripts.": "掲載内容はすべてお使いの Go バージョンで利用できます。removed または deprecated の項目はスクリプトで使用しないでください。",
+
+		// Version comparison
+		"Go Version Comparison (%s vs %s)": "Go バージョン比較 (%s と %s)",
+		"Overview":                         "概要比較",
+		"Difference":                       "差分",
+		"Language changes":                 "言語の変更",
+		"Breaking changes":                 "破壊的変更",
+		"New APIs":                         "新しい API",
+		"Packages touched":                 "変更されたパッケージ",
+		"Packages Changed After %s":        "%s 以降に変更されたパッケージ",
+		"No package changes between the two versions.": "2 つのバージョン間でパッケージの変更はありません。",
+		"New APIs (%s)": "新しい API (%s)",
+		"Go %s adds %d new APIs, %d language changes and %d breaking changes over Go %s, touching %d packages": "Go %[1]s では Go %[5]s と比べて新しい API が %[2]d 件、言語の変更が %[3]d 件、破壊的変更が %[4]d 件追加され、%[6]d 個のパッケージが変更されています",
+		"Changed in": "変更されたバージョン",
+		"Counts are cumulative from the oldest supported release; use go-updates with a package filter for the individual APIs.": "件数はサポート対象の最も古いリリースからの累計です。個々の API は go-updates のパッケージフィルターで確認してください。",
+
+		// Package history
+		"Package History: `%s`": "パッケージの履歴: `%s`",
+		"Requires upgrading to Go %s or later; do not use these APIs in a Go %s project.":                               "Go %[1]s 以降へのアップグレードが必要です。Go %[2]s のプロジェクトではこれらの API を使用しないでください。",
+		"Only releases up to Go %s can be used as-is; suggest upgrading when a needed API is marked not yet available.": "そのまま使用できるのは Go %s までのリリースのみです。必要な API が「まだ利用できません」と表示されている場合はアップグレードを提案してください。",
+		"Pass your project's Go version to see which of these changes you can use.":                                     "プロジェクトの Go バージョンを指定すると、どの変更を利用できるかを確認できます。",
+		"Package '%s' changed in %d releases":                                                                           "パッケージ '%s' は %d 件のリリースで変更されています",
+		"Package '%s' changed in %d releases; every change is available in Go %s":                                       "パッケージ '%[1]s' は %[2]d 件のリリースで変更されています。すべての変更を Go %[3]s で利用できます",
+		"Package '%s' changed in %d releases; %d of them are newer than Go %s and not yet available":                    "パッケージ '%[1]s' は %[2]d 件のリリースで変更されています。そのうち %[3]d 件は Go %[4]s より新しく、まだ利用できません",
+
+		// Versions
+		"Supported Go Versions": "サポートされている Go のバージョン",
+		"Releases":              "リリース",
+		"Highlights":            "主な変更",
+		"Version":               "バージョン",
+		"Released":              "リリース日",
+		"Counts cover the changes made by each release alone; pass any listed version to the other tools.": "件数は各リリース単独の変更数です。掲載されているバージョンは他のツールにも指定できます。",
+		"Go %s through %s (%d releases) are available":                                                     "Go %s から %s まで (%d 件のリリース) を利用できます",
+	},
+}
+
+// sprintf formats a message of the catalog
+// Services pass fmt.Sprintf to keep English summaries in their responses; formatters pass tf to translate the same message
+type sprintf func(format string, args ...any) string
+
+// serviceText returns text built by a service in English, or builds it again from the catalog of another locale
+func (f *DefaultResponseFormatter) serviceText(english string, build func(p sprintf) string) string {
+	if _, exists := messageCatalogs[f.locale]; !exists {
+		return english
+	}
+	return build(f.tf)
+}
+
+// Locales returns the supported locales, including the default English locale
+func Locales() []string {
+	locales := append(slices.Collect(maps.Keys(messageCatalogs)), domain.DefaultLocale)
+	slices.Sort(locales)
+	return locales
+}
+
+// IsSupportedLocale reports whether locale has a message catalog or is the default locale
+func IsSupportedLocale(locale string) bool {
+	_, exists := messageCatalogs[locale]
+	return exists || locale == domain.DefaultLocale
+}
+
+// t returns the translation of a fixed string for the formatter's locale, falling back to English
+func (f *DefaultResponseFormatter) t(text string) string {
+	if translated, exists := messageCatalogs[f.locale][text]; exists {
+		return translated
+	}
+	return text
+}
+
+// tf translates a format string and formats it with args
+func (f *DefaultResponseFormatter) tf(format string, args ...any) string {
+	return fmt.Sprintf(f.t(format), args...)
+}
+
+// localize returns the translation of a data field for the formatter's locale, falling back to English
+func (f *DefaultResponseFormatter) localize(text string, translations map[string]string) string {
+	if translated, exists := translations[f.locale]; exists && translated != "" {
+		return translated
+	}
+	return text
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+// formatVerbPattern matches fmt verbs, with or without an explicit argument index
+var formatVerbPattern = regexp.MustCompile(`%(\[\d+\])?[a-z]`)
+
+func TestMessageCatalogs_KeepFormatVerbs(t *testing.T) {
+	for locale, catalog := range messageCatalogs {
+		for key, translation := range catalog {
+			expected := len(formatVerbPattern.FindAllString(key, -1))
+			if got := len(formatVerbPattern.FindAllString(translation, -1)); got != expected {
+				t.Errorf("%s: %q has %d format verbs, translation %q has %d", locale, key, expected, translation, got)
+			}
+		}
+	}
+}
+
+func TestMessageCatalogs_TranslateServiceText(t *testing.T) {
+	// Records every message the summaries and migration checks build, on every branch
+	used := make(map[string]bool)
+	p := func(format string, args ...any) string {
+		used[format] = true
+		return fmt.Sprintf(format, args...)
+	}
+
+	features := &domain.FeatureResponse{ToVersion: "1.22", FromVersion: "1.21", PackageInfo: map[string][]domain.PackageChange{"slices": {}}}
+	featureSummary(p, features, "")
+	featureSummary(p, features, "slices")
+	featureSummary(p, &domain.FeatureResponse{ToVersion: "1.22"}, "")
+	featureSummary(p, &domain.FeatureResponse{ToVersion: "1.22"}, "slices")
+	featureSummary(p, &domain.FeatureResponse{ToVersion: "1.22", SearchTerm: "iter"}, "")
+
+	migrationSummary(p, &domain.MigrationGuide{FromVersion: "1.21", ToVersion: "1.22"})
+	migrationSummary(p, &domain.MigrationGuide{FromVersion: "1.21", ToVersion: "1.22", Steps: []domain.MigrationStep{{Impact: "breaking"}}})
+	for category := range categoryChecks {
+		stepCheck(p, domain.MigrationStep{Category: category})
+	}
+	stepCheck(p, domain.MigrationStep{Category: "other"})
+	stepCheck(p, domain.MigrationStep{Package: "math/rand", Symbol: "Seed", Impact: "deprecation"})
+	stepCheck(p, domain.MigrationStep{Package: "net/http", Impact: "breaking"})
+
+	later := &domain.PortStatus{Status: "supported", Since: "1.21"}
+	experimental := &domain.PortStatus{Status: "experimental", Since: "1.21"}
+	for _, report := range []*domain.PlatformReport{
+		{Version: "1.22"},
+		{Version: "1.22", GOOS: "darwin"},
+		{Version: "1.22", GOOS: "darwin", Requirements: []domain.OSRequirement{{MinOSVersion: "11"}}},
+		{Version: "1.22", GOOS: "linux", GOARCH: "arm64"},
+		{Version: "1.19", GOOS: "linux", GOARCH: "loong64", LaterPort: later},
+		{Version: "1.19", GOOS: "wasip1", GOARCH: "wasm", LaterPort: experimental},
+		{Version: "1.22", GOOS: "linux", GOARCH: "loong64", Ports: []domain.PortStatus{{GOARCH: "loong64", Status: "supported"}}},
+		{Version: "1.22", GOOS: "nacl", GOARCH: "386", Ports: []domain.PortStatus{{GOARCH: "386", Status: "removed"}}},
+		{Version: "1.22", GOOS: "wasip1", GOARCH: "wasm", Ports: []domain.PortStatus{{GOARCH: "wasm", Status: "experimental"}}},
+	} {
+		platformSummary(p, report)
+	}
+
+	toolchainSummary(p, &domain.ToolchainReport{Version: "1.22"})
+	toolchainSummary(p, &domain.ToolchainReport{Version: "1.22", Command: "go vet"})
+
+	godebugSummary(p, &domain.GodebugReport{GoVersion: "1.22"})
+	godebugSummary(p, &domain.GodebugReport{GoVersion: "1.22", CompareTo: "1.21"})
+	deprecationSummary(p, &domain.DeprecationReport{Version: "1.22"})
+	deprecationSummary(p, &domain.DeprecationReport{Symbol: "io/ioutil"})
+	deprecationSummary(p, &domain.DeprecationReport{Symbol: "io/ioutil", Deprecations: []domain.DeprecationRecord{{}}})
+	comparisonSummary(p, &domain.VersionComparison{FromVersion: "1.21", ToVersion: "1.22"})
+	packageHistorySummary(p, &domain.PackageHistory{Package: "slices"})
+	packageHistorySummary(p, &domain.PackageHistory{Package: "slices", Version: "1.22"})
+	packageHistorySummary(p, &domain.PackageHistory{Package: "slices", Version: "1.21", Entries: []domain.PackageHistoryEntry{{Version: "1.22"}}})
+	catalogSummary(p, &domain.VersionCatalog{OldestVersion: "1.13", LatestVersion: "1.24"})
+
+	for locale, catalog := range messageCatalogs {
+		for message := range used {
+			if _, exists := catalog[message]; !exists {
+				t.Errorf("%s: no translation of %q", locale, message)
+			}
+		}
+	}
+}
+
+func TestLocales(t *testing.T) {
+	locales := Locales()
+	if !slices.Equal(locales, []string{"en", "ja"}) {
+		t.Errorf("Expected [en ja], got %v", locales)
+	}
+	if !IsSupportedLocale("ja") || !IsSupportedLocale(domain.DefaultLocale) || IsSupportedLocale("xx") {
+		t.Error("IsSupportedLocale disagrees with Locales")
+	}
+}
+
+func TestResponseFormatter_Localized(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator(), WithLocale("ja"))
+
+	changes := []domain.Change{
+		{
+			Category:                "language",
+			Description:             "for-range over integers",
+			Impact:                  "new",
+			DescriptionTranslations: map[string]string{"ja": "整数に対する for-range"},
+		},
+		{Category: "runtime", Description: "Faster GC", Impact: "performance"},
+	}
+	response := &domain.FeatureResponse{
+		FromVersion:    "1.21",
+		ToVersion:      "1.22",
+		Summary:        "All Go features available in your project (Go 1.22): 2 changes across 0 packages from Go 1.21",
+		Changes:        changes,
+		VersionChanges: map[string][]domain.Change{"1.22": changes},
+		VersionPackages: map[string]map[string][]domain.PackageChange{
+			"1.22": {},
+		},
+	}
+
+	result := formatter.FormatAsText(response, "1.22", "")
+
+	// Translated entries use the overlay text; untranslated entries fall back to English
+	expected := `# 利用可能な Go の機能 (Go 1.22)
+
+## 概要
+プロジェクト (Go 1.22) で利用できるすべての Go の機能: Go 1.21 からの 2 件の変更と 0 個のパッケージ
+
+## Go 1.22 の機能
+
+### 言語とランタイムの変更
+- **language** (new): 整数に対する for-range
+- **runtime** (performance): Faster GC
+
+
+## 補足
+以上がプロジェクトのバージョンで利用できる Go の機能です。モダンで効率的な Go コードを書くために活用してください。
+`
+
+	if result != expected {
+		t.Errorf("Expected:\n%s\n\nGot:\n%s", expected, result)
+	}
+
+	t.Run("summaries built by services", func(t *testing.T) {
+		history := &domain.PackageHistory{Package: "slices", Summary: "Package 'slices' changed in 0 releases"}
+		if got := formatter.FormatPackageHistory(history); !strings.Contains(got, "パッケージ 'slices' は 0 件のリリースで変更されています") {
+			t.Errorf("Expected a Japanese package history summary, got:\n%s", got)
+		}
+		report := &domain.DeprecationReport{Symbol: "io/ioutil", Summary: "No deprecation is recorded for io/ioutil"}
+		if got := formatter.FormatDeprecationReport(report); !strings.Contains(got, "io/ioutil の非推奨化は記録されていません") {
+			t.Errorf("Expected a Japanese deprecation summary, got:\n%s", got)
+		}
+	})
+
+	t.Run("unknown locale falls back to English", func(t *testing.T) {
+		english := NewResponseFormatter(version.NewSemanticVersionComparator(), WithLocale("xx"))
+		if got := english.FormatAsText(response, "1.22", ""); !strings.HasPrefix(got, "# Go Features Available (Go 1.22)") {
+			t.Errorf("Expected English output, got:\n%s", got)
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// categoryChecks provides default "what to check" guidance per change category
+var categoryChecks = map[string]string{
+	"language":    "Raise the go directive in go.mod on a branch and rerun the full test suite; look for code relying on the previous semantics.",
+	"runtime":     "Run load and integration tests against the new runtime and compare behavior, memory usage and panics with the previous version.",
+	"toolchain":   "Update Makefiles, CI pipelines and developer scripts that invoke the affected go command, flag or environment variable.",
+	"platform":    "Verify that every GOOS/GOARCH target and minimum OS version you deploy to is still supported.",
+	"performance": "Benchmark hot paths before and after upgrading.",
+}
+
+// DefaultMigrationService implements MigrationService
+type DefaultMigrationService struct {
+	repository domain.ReleaseRepository
+	comparator domain.VersionComparator
+}
+
+// NewMigrationService creates a new migration service
+func NewMigrationService(repository domain.ReleaseRepository, comparator domain.VersionComparator) domain.MigrationService {
+	return &DefaultMigrationService{
+		repository: repository,
+		comparator: comparator,
+	}
+}
+
+// GetMigrationGuide returns the breaking changes and deprecations introduced after fromVersion up to toVersion
+func (s *DefaultMigrationService) GetMigrationGuide(ctx context.Context, fromVersion, toVersion string, includeExperimental bool) (*domain.MigrationGuide, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Validate input
+	if fromVersion == "" {
+		return nil, domain.NewValidationError("GetMigrationGuide", "from version cannot be empty", nil)
+	}
+
+	if toVersion == "" {
+		latest, err := s.repository.GetLatestVersion(ctx)
+		if err != nil {
+			return nil, domain.NewServiceError("GetMigrationGuide", "failed to get latest version", err)
+		}
+		toVersion = latest
+	}
+
+	if s.comparator.Compare(fromVersion, toVersion) >= 0 {
+		return nil, domain.NewValidationError("GetMigrationGuide", "from version must be older than to version", nil).
+			WithContext("fromVersion", fromVersion).
+			WithContext("toVersion", toVersion)
+	}
+
+	// Verify the starting version exists so typos are not silently treated as "very old"
+	if _, err := s.repository.GetReleaseByVersion(ctx, fromVersion); err != nil {
+		return nil, lookupError("GetMigrationGuide", "from version not found", err).
+			WithContext("fromVersion", fromVersion)
+	}
+
+	releases, err := s.repository.GetReleasesUpToVersion(ctx, toVersion)
+	if err != nil {
+		return nil, lookupError("GetMigrationGuide", "to version not found", err).
+			WithContext("toVersion", toVersion)
+	}
+
+	guide := &domain.MigrationGuide{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Steps:       make([]domain.MigrationStep, 0),
+	}
+
+	for _, release := range releases {
+		// Only releases after the current project version require migration
+		if s.comparator.Compare(release.Version, fromVersion) <= 0 {
+			continue
+		}
+
+		for _, change := range filterChanges(release.Changes, includeExperimental) {
+			if !requiresMigration(change.Impact) {
+				continue
+			}
+			guide.Steps = append(guide.Steps, domain.MigrationStep{
+				Version:        release.Version,
+				Category:       change.Category,
+				Impact:         change.Impact,
+				Description:    change.Description,
+				MigrationNotes: change.MigrationNotes,
+				WhatToCheck:    changeCheck(fmt.Sprintf, change.Category),
+			})
+		}
+
+		// Iterate packages in sorted order for deterministic output
+		for _, pkg := range slices.Sorted(maps.Keys(release.Packages)) {
+			for _, change := range filterPackageChanges(release.Packages[pkg], includeExperimental) {
+				if !requiresMigration(change.Impact) {
+					continue
+				}
+				guide.Steps = append(guide.Steps, domain.MigrationStep{
+					Version:        release.Version,
+					Category:       "library",
+					Package:        pkg,
+					Symbol:         change.Function,
+					Impact:         change.Impact,
+					Description:    change.Description,
+					MigrationNotes: change.MigrationNotes,
+					WhatToCheck:    packageChangeCheck(fmt.Sprintf, pkg, change.Function, change.Impact),
+				})
+			}
+		}
+	}
+
+	guide.Summary = migrationSummary(fmt.Sprintf, guide)
+
+	return guide, nil
+}
+
+// requiresMigration reports whether an impact value needs attention when upgrading
+func requiresMigration(impact string) bool {
+	return impact == "breaking" || impact == "deprecation"
+}
+
+// changeCheck returns the "what to check" guidance for a general change of category
+func changeCheck(p sprintf, category string) string {
+	if check, exists := categoryChecks[category]; exists {
+		return p(check)
+	}
+	return p("Review code affected by this change and rerun your test suite.")
+}
+
+// packageChangeCheck returns the "what to check" guidance for a change of function in pkg
+func packageChangeCheck(p sprintf, pkg, function, impact string) string {
+	symbol := pkg
+	if function != "" {
+		symbol = pkg + "." + function
+	}
+
+	if impact == "deprecation" {
+		return p("Find uses of %s (go vet and staticcheck SA1019 report deprecated identifiers) and move to the recommended replacement.", symbol)
+	}
+	return p("Search for uses of %s and rerun the tests that cover them.", symbol)
+}
+
+// stepCheck returns the "what to check" guidance for a migration step
+func stepCheck(p sprintf, step domain.MigrationStep) string {
+	if step.Package != "" {
+		return packageChangeCheck(p, step.Package, step.Symbol, step.Impact)
+	}
+	return changeCheck(p, step.Category)
+}
+
+// migrationSummary creates the summary line for a guide
+func migrationSummary(p sprintf, guide *domain.MigrationGuide) string {
+	breaking, deprecations := 0, 0
+	for _, step := range guide.Steps {
+		if step.Impact == "breaking" {
+			breaking++
+		} else {
+			deprecations++
+		}
+	}
+
+	if breaking == 0 && deprecations == 0 {
+		return p("No breaking changes or deprecations between Go %s and Go %s", guide.FromVersion, guide.ToVersion)
+	}
+	return p("%d breaking changes and %d deprecations to review when upgrading from Go %s to Go %s", breaking, deprecations, guide.FromVersion, guide.ToVersion)
+}
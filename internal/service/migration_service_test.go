@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultMigrationService_GetMigrationGuide(t *testing.T) {
+	testReleases := []*domain.GoRelease{
+		{
+			Version: "1.21",
+			Changes: []domain.Change{
+				{Category: "platform", Description: "Old platforms dropped", Impact: "breaking"},
+			},
+		},
+		{
+			Version: "1.22",
+			Changes: []domain.Change{
+				{Category: "language", Description: "Loop variable semantics", Impact: "breaking", MigrationNotes: "Raise the go directive"},
+				{Category: "language", Description: "Range over integers", Impact: "new"},
+				{Category: "runtime", Description: "Arena semantics", Impact: "breaking", Experimental: true},
+			},
+			Packages: map[string][]domain.PackageChange{
+				"net/http": {
+					{Function: "ServeMux", Description: "New patterns", Impact: "breaking"},
+				},
+				"math/rand": {
+					{Function: "Seed", Description: "Seed deprecated", Impact: "deprecation"},
+				},
+			},
+		},
+	}
+
+	repo := &mockRepository{releases: testReleases}
+	service := NewMigrationService(repo, &mockComparator{})
+	ctx := context.Background()
+
+	t.Run("collects breaking changes and deprecations after from version", func(t *testing.T) {
+		guide, err := service.GetMigrationGuide(ctx, "1.21", "1.22", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(guide.Steps) != 3 {
+			t.Fatalf("expected 3 steps, got %d: %+v", len(guide.Steps), guide.Steps)
+		}
+
+		// General changes come first, then packages in sorted order
+		if guide.Steps[0].MigrationNotes != "Raise the go directive" {
+			t.Errorf("expected migration notes to be carried over, got %q", guide.Steps[0].MigrationNotes)
+		}
+		if guide.Steps[1].Package != "math/rand" || guide.Steps[1].Impact != "deprecation" {
+			t.Errorf("expected math/rand deprecation second, got %+v", guide.Steps[1])
+		}
+		if guide.Steps[2].Symbol != "ServeMux" {
+			t.Errorf("expected ServeMux step last, got %+v", guide.Steps[2])
+		}
+		for _, step := range guide.Steps {
+			if step.WhatToCheck == "" {
+				t.Errorf("expected what-to-check guidance for %q", step.Description)
+			}
+		}
+	})
+
+	t.Run("experimental changes on request", func(t *testing.T) {
+		guide, err := service.GetMigrationGuide(ctx, "1.21", "1.22", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(guide.Steps) != 4 || guide.Steps[1].Description != "Arena semantics" {
+			t.Errorf("expected the experimental breaking change as a step, got %+v", guide.Steps)
+		}
+	})
+
+	t.Run("defaults to latest version", func(t *testing.T) {
+		guide, err := service.GetMigrationGuide(ctx, "1.21", "", false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if guide.ToVersion != "1.22" {
+			t.Errorf("expected ToVersion 1.22, got %s", guide.ToVersion)
+		}
+	})
+
+	t.Run("rejects non-increasing range", func(t *testing.T) {
+		_, err := service.GetMigrationGuide(ctx, "1.22", "1.21", false)
+		if !domain.IsValidationError(err) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("unknown from version", func(t *testing.T) {
+		_, err := service.GetMigrationGuide(ctx, "1.10", "1.22", false)
+		if !domain.IsNotFoundError(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("repository failures are not reported as not found", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleaseByVersion", "release data is reloading", nil)
+		failing := NewMigrationService(&failingRepository{mockRepository: repo, err: cause}, &mockComparator{})
+		_, err := failing.GetMigrationGuide(ctx, "1.21", "1.22", false)
+		if domain.IsNotFoundError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+}
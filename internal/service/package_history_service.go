@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// DefaultPackageHistoryService implements PackageHistoryService
+type DefaultPackageHistoryService struct {
+	repository domain.ReleaseRepository
+	comparator domain.VersionComparator
+}
+
+// NewPackageHistoryService creates a new package history service
+func NewPackageHistoryService(repository domain.ReleaseRepository, comparator domain.VersionComparator) domain.PackageHistoryService {
+	return &DefaultPackageHistoryService{
+		repository: repository,
+		comparator: comparator,
+	}
+}
+
+// GetPackageHistory returns every release that changed a package, including releases newer than the queried version
+// Experimental entries are hidden unless the query opts in, like in QueryFeatures
+func (s *DefaultPackageHistoryService) GetPackageHistory(ctx context.Context, query domain.PackageHistoryQuery) (*domain.PackageHistory, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Validate input
+	packageName, version := NormalizePackageArg(query.Package), query.Version
+	if packageName == "" {
+		return nil, domain.NewValidationError("GetPackageHistory", "package cannot be empty", nil)
+	}
+
+	if version != "" {
+		if _, err := s.repository.GetReleaseByVersion(ctx, version); err != nil {
+			return nil, lookupError("GetPackageHistory", "version not found", err).
+				WithContext("version", version)
+		}
+	}
+
+	versions, err := s.repository.GetPackageVersions(ctx, packageName)
+	if err != nil {
+		return nil, lookupError("GetPackageHistory", "package not found", err).
+			WithContext("package", packageName)
+	}
+
+	history := &domain.PackageHistory{
+		Package: packageName,
+		Version: version,
+		Entries: make([]domain.PackageHistoryEntry, 0, len(versions)),
+	}
+
+	for _, v := range versions {
+		release, err := s.repository.GetReleaseByVersion(ctx, v)
+		if err != nil {
+			return nil, domain.NewServiceError("GetPackageHistory", "failed to get release", err).
+				WithContext("version", v)
+		}
+
+		changes := filterPackageChanges(release.Packages[packageName], query.IncludeExperimental)
+		if len(changes) == 0 {
+			continue
+		}
+		history.Entries = append(history.Entries, domain.PackageHistoryEntry{
+			Version:   v,
+			Available: version == "" || s.comparator.Compare(v, version) <= 0,
+			Changes:   changes,
+		})
+	}
+
+	history.Summary = packageHistorySummary(fmt.Sprintf, history)
+
+	return history, nil
+}
+
+// packageHistorySummary creates the summary line for a package history
+func packageHistorySummary(p sprintf, history *domain.PackageHistory) string {
+	if history.Version == "" {
+		return p("Package '%s' changed in %d releases", history.Package, len(history.Entries))
+	}
+
+	upcoming := 0
+	for _, entry := range history.Entries {
+		if !entry.Available {
+			upcoming++
+		}
+	}
+	if upcoming == 0 {
+		return p("Package '%s' changed in %d releases; every change is available in Go %s", history.Package, len(history.Entries), history.Version)
+	}
+	return p("Package '%s' changed in %d releases; %d of them are newer than Go %s and not yet available", history.Package, len(history.Entries), upcoming, history.Version)
+}
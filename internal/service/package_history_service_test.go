@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultPackageHistoryService_GetPackageHistory(t *testing.T) {
+	testReleases := []*domain.GoRelease{
+		{
+			Version: "1.21",
+			Packages: map[string][]domain.PackageChange{
+				"slices": {{Function: "Sort", Description: "Sort a slice", Impact: "new"}},
+			},
+		},
+		{
+			Version: "1.22",
+			Packages: map[string][]domain.PackageChange{
+				"net/http": {{Function: "ServeMux", Description: "Enhanced routing", Impact: "enhancement"}},
+			},
+		},
+		{
+			Version: "1.23",
+			Packages: map[string][]domain.PackageChange{
+				"slices": {{Function: "Collect", Description: "Collect an iterator", Impact: "new"}},
+			},
+		},
+		{
+			Version: "1.24",
+			Packages: map[string][]domain.PackageChange{
+				"testing/synctest": {{Description: "Test concurrent code", Impact: "new", Experimental: true, GoExperiment: "synctest"}},
+			},
+		},
+	}
+
+	repo := &mockRepository{releases: testReleases}
+	service := NewPackageHistoryService(repo, &mockComparator{})
+	ctx := context.Background()
+
+	t.Run("newer releases are marked unavailable", func(t *testing.T) {
+		history, err := service.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: "slices/", Version: "1.22"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if history.Package != "slices" {
+			t.Errorf("expected normalized package name, got %q", history.Package)
+		}
+		if len(history.Entries) != 2 {
+			t.Fatalf("expected 2 entries, got %+v", history.Entries)
+		}
+		if history.Entries[0].Version != "1.21" || !history.Entries[0].Available {
+			t.Errorf("expected 1.21 to be available, got %+v", history.Entries[0])
+		}
+		if history.Entries[1].Version != "1.23" || history.Entries[1].Available {
+			t.Errorf("expected 1.23 to be unavailable, got %+v", history.Entries[1])
+		}
+	})
+
+	t.Run("without a version everything is available", func(t *testing.T) {
+		history, err := service.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: "slices"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, entry := range history.Entries {
+			if !entry.Available {
+				t.Errorf("expected %s to be available", entry.Version)
+			}
+		}
+	})
+
+	t.Run("experimental changes are hidden unless requested", func(t *testing.T) {
+		history, err := service.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: "testing/synctest"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(history.Entries) != 0 {
+			t.Errorf("expected experimental changes to be hidden, got %+v", history.Entries)
+		}
+
+		history, err = service.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: "testing/synctest", IncludeExperimental: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(history.Entries) != 1 || !history.Entries[0].Changes[0].Experimental {
+			t.Errorf("expected the experimental change when opted in, got %+v", history.Entries)
+		}
+	})
+
+	t.Run("unknown package", func(t *testing.T) {
+		_, err := service.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: "nonexistent", Version: "1.22"})
+		if !domain.IsNotFoundError(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := service.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: "slices", Version: "9.99"})
+		if !domain.IsNotFoundError(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("empty package", func(t *testing.T) {
+		_, err := service.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: " ", Version: "1.22"})
+		if !domain.IsValidationError(err) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("repository failures are not reported as not found", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleasesUpToVersion", "release data is reloading", nil)
+		failing := NewPackageHistoryService(&failingRepository{mockRepository: repo, err: cause}, &mockComparator{})
+		_, err := failing.GetPackageHistory(ctx, domain.PackageHistoryQuery{Package: "slices", Version: "1.22"})
+		if domain.IsNotFoundError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// portStatuses maps the status of a PlatformChange to the resulting port status
+var portStatuses = map[string]string{
+	"added":        "supported",
+	"experimental": "experimental",
+	"removed":      "removed",
+	"broken":       "broken",
+}
+
+// DefaultPlatformService implements PlatformService
+type DefaultPlatformService struct {
+	repository domain.ReleaseRepository
+}
+
+// NewPlatformService creates a new platform service
+func NewPlatformService(repository domain.ReleaseRepository) domain.PlatformService {
+	return &DefaultPlatformService{
+		repository: repository,
+	}
+}
+
+// GetPlatformSupport replays the platform changes of every release up to version
+func (s *DefaultPlatformService) GetPlatformSupport(ctx context.Context, version, goos, goarch string) (*domain.PlatformReport, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Validate input
+	if version == "" {
+		return nil, domain.NewValidationError("GetPlatformSupport", "version cannot be empty", nil)
+	}
+	if goos == "" && goarch != "" {
+		return nil, domain.NewValidationError("GetPlatformSupport", "goarch requires goos", nil).
+			WithContext("goarch", goarch)
+	}
+
+	releases, err := s.repository.GetReleasesUpToVersion(ctx, version)
+	if err != nil {
+		return nil, lookupError("GetPlatformSupport", "version not found", err).
+			WithContext("version", version)
+	}
+
+	ports := make(map[string]domain.PortStatus)
+	requirements := make(map[string]domain.OSRequirement)
+
+	// Releases are ordered from oldest to newest, so later changes overwrite earlier ones
+	for _, release := range releases {
+		for _, change := range release.Platforms {
+			if !matchesPlatform(change, goos, goarch) {
+				continue
+			}
+
+			if change.MinOSVersion != "" {
+				requirements[change.GOOS] = domain.OSRequirement{
+					GOOS:         change.GOOS,
+					MinOSVersion: change.MinOSVersion,
+					Since:        release.Version,
+					Description:  change.Description,
+				}
+			}
+
+			if status, exists := portStatuses[change.Status]; exists {
+				ports[platformName(change.GOOS, change.GOARCH)] = domain.PortStatus{
+					GOOS:        change.GOOS,
+					GOARCH:      change.GOARCH,
+					Status:      status,
+					Since:       release.Version,
+					Description: change.Description,
+				}
+			}
+		}
+	}
+
+	report := &domain.PlatformReport{
+		Version:      version,
+		GOOS:         goos,
+		GOARCH:       goarch,
+		Ports:        make([]domain.PortStatus, 0, len(ports)),
+		Requirements: make([]domain.OSRequirement, 0, len(requirements)),
+	}
+	for _, name := range slices.Sorted(maps.Keys(ports)) {
+		report.Ports = append(report.Ports, ports[name])
+	}
+	for _, name := range slices.Sorted(maps.Keys(requirements)) {
+		report.Requirements = append(report.Requirements, requirements[name])
+	}
+
+	// A port missing so far may be added by a later release, which the summary names
+	if goarch != "" {
+		if _, found := findPort(report.Ports, goarch); !found {
+			later, err := s.repository.GetReleasesAfterVersion(ctx, version)
+			if err != nil {
+				return nil, err
+			}
+			report.LaterPort = firstPortAddition(later, goos, goarch)
+		}
+	}
+
+	report.Summary = platformSummary(fmt.Sprintf, report)
+
+	return report, nil
+}
+
+// matchesPlatform reports whether change applies to goos and goarch; empty values match everything
+func matchesPlatform(change domain.PlatformChange, goos, goarch string) bool {
+	if goos != "" && change.GOOS != goos {
+		return false
+	}
+	return goarch == "" || change.GOARCH == "" || change.GOARCH == goarch
+}
+
+// firstPortAddition returns the first release of releases adding the port as supported or experimental
+func firstPortAddition(releases []*domain.GoRelease, goos, goarch string) *domain.PortStatus {
+	for _, release := range releases {
+		for _, change := range release.Platforms {
+			if !matchesPlatform(change, goos, goarch) || (change.Status != "added" && change.Status != "experimental") {
+				continue
+			}
+			return &domain.PortStatus{
+				GOOS:        change.GOOS,
+				GOARCH:      change.GOARCH,
+				Status:      portStatuses[change.Status],
+				Since:       release.Version,
+				Description: change.Description,
+			}
+		}
+	}
+	return nil
+}
+
+// platformName joins goos and goarch ("linux/loong64"); an empty goarch yields the bare goos
+func platformName(goos, goarch string) string {
+	if goarch == "" {
+		return goos
+	}
+	return goos + "/" + goarch
+}
+
+// platformSummary answers the question behind the report in one sentence
+func platformSummary(p sprintf, report *domain.PlatformReport) string {
+	if report.GOOS == "" {
+		return p("Port changes and minimum OS versions recorded up to Go %s", report.Version)
+	}
+
+	var parts []string
+	if report.GOARCH != "" {
+		name := platformName(report.GOOS, report.GOARCH)
+		port, found := findPort(report.Ports, report.GOARCH)
+		switch {
+		case !found && report.LaterPort != nil && report.LaterPort.Status == "experimental":
+			parts = append(parts, p("%s is not supported until Go %s, which adds it as an experimental port", name, report.LaterPort.Since))
+		case !found && report.LaterPort != nil:
+			parts = append(parts, p("%s is not supported until Go %s", name, report.LaterPort.Since))
+		case !found:
+			parts = append(parts, p("No port change is recorded for %s up to Go %s; ports that predate the recorded data are supported unless listed as removed", name, report.Version))
+		case port.Status == "supported":
+			parts = append(parts, p("%s is supported in Go %s (since Go %s)", name, report.Version, port.Since))
+		case port.Status == "removed":
+			parts = append(parts, p("%s is not supported in Go %s (removed in Go %s)", name, report.Version, port.Since))
+		default:
+			parts = append(parts, p("%s is %s in Go %s (since Go %s)", name, port.Status, report.Version, port.Since))
+		}
+	}
+
+	if len(report.Requirements) > 0 {
+		requirement := report.Requirements[0]
+		parts = append(parts, p("Go %s requires %s %s or later (since Go %s)", report.Version, report.GOOS, requirement.MinOSVersion, requirement.Since))
+	} else if report.GOARCH == "" {
+		parts = append(parts, p("No minimum OS version is recorded for %s up to Go %s", report.GOOS, report.Version))
+	}
+
+	return strings.Join(parts, p(". "))
+}
+
+// findPort returns the status for goarch, preferring an exact match over a GOOS-wide entry
+func findPort(ports []domain.PortStatus, goarch string) (domain.PortStatus, bool) {
+	idx := slices.IndexFunc(ports, func(port domain.PortStatus) bool {
+		return port.GOARCH == goarch
+	})
+	if idx >= 0 {
+		return ports[idx], true
+	}
+
+	idx = slices.IndexFunc(ports, func(port domain.PortStatus) bool {
+		return port.GOARCH == ""
+	})
+	if idx >= 0 {
+		return ports[idx], true
+	}
+
+	return domain.PortStatus{}, false
+}
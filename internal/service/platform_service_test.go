@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultPlatformService_GetPlatformSupport(t *testing.T) {
+	testReleases := []*domain.GoRelease{
+		{
+			Version: "1.19",
+			Platforms: []domain.PlatformChange{
+				{GOOS: "linux", GOARCH: "loong64", Status: "added", Description: "LoongArch port"},
+			},
+		},
+		{
+			Version: "1.21",
+			Platforms: []domain.PlatformChange{
+				{GOOS: "darwin", Status: "updated", MinOSVersion: "10.15", Description: "Requires Catalina"},
+				{GOOS: "wasip1", GOARCH: "wasm", Status: "experimental", Description: "WASI port"},
+			},
+		},
+		{
+			Version: "1.23",
+			Platforms: []domain.PlatformChange{
+				{GOOS: "darwin", Status: "updated", MinOSVersion: "11", Description: "Requires Big Sur"},
+			},
+		},
+	}
+
+	repo := &mockRepository{releases: testReleases}
+	service := NewPlatformService(repo)
+	ctx := context.Background()
+
+	t.Run("port supported", func(t *testing.T) {
+		report, err := service.GetPlatformSupport(ctx, "1.21", "linux", "loong64")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(report.Ports) != 1 || report.Ports[0].Status != "supported" || report.Ports[0].Since != "1.19" {
+			t.Errorf("expected linux/loong64 supported since 1.19, got %+v", report.Ports)
+		}
+		if !strings.HasPrefix(report.Summary, "linux/loong64 is supported in Go 1.21") {
+			t.Errorf("unexpected summary: %s", report.Summary)
+		}
+	})
+
+	t.Run("port not yet added", func(t *testing.T) {
+		report, err := service.GetPlatformSupport(ctx, "1.19", "wasip1", "wasm")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(report.Ports) != 0 {
+			t.Errorf("expected no port records, got %+v", report.Ports)
+		}
+		if report.LaterPort == nil || report.LaterPort.Since != "1.21" {
+			t.Errorf("expected the port to be added in 1.21, got %+v", report.LaterPort)
+		}
+		if !strings.HasPrefix(report.Summary, "wasip1/wasm is not supported until Go 1.21, which adds it as an experimental port") {
+			t.Errorf("unexpected summary: %s", report.Summary)
+		}
+
+		report, err = service.GetPlatformSupport(ctx, "1.19", "linux", "arm64")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if report.LaterPort != nil || !strings.HasPrefix(report.Summary, "No port change is recorded for linux/arm64 up to Go 1.19") {
+			t.Errorf("expected a port without records to be assumed supported, got %+v: %s", report.LaterPort, report.Summary)
+		}
+	})
+
+	t.Run("minimum OS version follows the latest change", func(t *testing.T) {
+		report, err := service.GetPlatformSupport(ctx, "1.23", "darwin", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(report.Requirements) != 1 || report.Requirements[0].MinOSVersion != "11" {
+			t.Errorf("expected darwin 11, got %+v", report.Requirements)
+		}
+	})
+
+	t.Run("goarch requires goos", func(t *testing.T) {
+		if _, err := service.GetPlatformSupport(ctx, "1.23", "", "amd64"); !domain.IsValidationError(err) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("repository failures are not reported as not found", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleasesUpToVersion", "release data is reloading", nil)
+		failing := NewPlatformService(&failingRepository{mockRepository: repo, err: cause})
+		_, err := failing.GetPlatformSupport(ctx, "1.21", "linux", "loong64")
+		if domain.IsNotFoundError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+}
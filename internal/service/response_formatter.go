@@ -1,7 +1,9 @@
 package service
 
 import (
+	"maps"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/tenkoh/recent-go-mcp/internal/domain"
@@ -9,20 +11,70 @@ import (
 
 // DefaultResponseFormatter implements ResponseFormatter
 type DefaultResponseFormatter struct {
-	comparator domain.VersionComparator
+	comparator       domain.VersionComparator
+	unverifiedBadges bool
+	locale           string
+	detail           string
+	examples         bool
+}
+
+// ResponseFormatterOption configures a DefaultResponseFormatter
+type ResponseFormatterOption func(*DefaultResponseFormatter)
+
+// WithUnverifiedBadges marks entries that have not been verified against the upstream documentation
+func WithUnverifiedBadges() ResponseFormatterOption {
+	return func(f *DefaultResponseFormatter) {
+		f.unverifiedBadges = true
+	}
+}
+
+// WithLocale translates fixed strings and, where the data provides them, descriptions into locale
+// Strings without a translation fall back to English
+func WithLocale(locale string) ResponseFormatterOption {
+	return func(f *DefaultResponseFormatter) {
+		f.locale = locale
+	}
+}
+
+// WithDetailLevel sets how much of each change feature listings show (domain.DetailFull by default)
+// Code examples are shown at domain.DetailFull only, unless WithExamples follows
+func WithDetailLevel(level string) ResponseFormatterOption {
+	return func(f *DefaultResponseFormatter) {
+		f.detail = level
+		f.examples = level != domain.DetailBrief
+	}
+}
+
+// WithExamples overrides whether feature listings show the code examples of package changes
+func WithExamples(include bool) ResponseFormatterOption {
+	return func(f *DefaultResponseFormatter) {
+		f.examples = include
+	}
 }
 
 // NewResponseFormatter creates a new response formatter
-func NewResponseFormatter(comparator domain.VersionComparator) domain.ResponseFormatter {
-	return &DefaultResponseFormatter{
+func NewResponseFormatter(comparator domain.VersionComparator, opts ...ResponseFormatterOption) domain.ResponseFormatter {
+	return newDefaultResponseFormatter(comparator, opts...)
+}
+
+// newDefaultResponseFormatter creates the concrete formatter shared by the output formats of the registry
+func newDefaultResponseFormatter(comparator domain.VersionComparator, opts ...ResponseFormatterOption) *DefaultResponseFormatter {
+	f := &DefaultResponseFormatter{
 		comparator: comparator,
+		locale:     domain.DefaultLocale,
+		detail:     domain.DetailFull,
+		examples:   true,
+	}
+	for _, opt := range opts {
+		opt(f)
 	}
+	return f
 }
 
 // FormatAsText formats a FeatureResponse as LLM-readable Markdown text
 func (f *DefaultResponseFormatter) FormatAsText(response *domain.FeatureResponse, version string, packageName string) string {
-	if len(response.Changes) == 0 && len(response.PackageInfo) == 0 {
-		return "# No Go Features Found\n\nNo Go features found for your project (Go " + response.ToVersion + ")."
+	if len(response.Changes) == 0 && len(response.PackageInfo) == 0 && len(response.Upcoming) == 0 {
+		return "# " + f.t("No Go Features Found") + "\n\n" + f.tf("No Go features found for your project (Go %s).", response.ToVersion)
 	}
 
 	// Use strings.Builder for efficient string construction
@@ -30,13 +82,11 @@ func (f *DefaultResponseFormatter) FormatAsText(response *domain.FeatureResponse
 	builder.Grow(2048) // Pre-allocate reasonable buffer size
 
 	// Write header
-	builder.WriteString("# Go Features Available (Go ")
-	builder.WriteString(response.ToVersion)
-	builder.WriteString(")\n\n")
+	builder.WriteString("# " + f.tf("Go Features Available (Go %s)", response.ToVersion) + "\n\n")
 
 	// Write summary
-	builder.WriteString("## Summary\n")
-	builder.WriteString(response.Summary)
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(f.serviceText(response.Summary, func(p sprintf) string { return featureSummary(p, response, packageName) }))
 	builder.WriteString("\n\n")
 
 	// Get sorted versions for chronological display using slices
@@ -46,6 +96,9 @@ func (f *DefaultResponseFormatter) FormatAsText(response *domain.FeatureResponse
 	}
 	f.sortVersions(versions)
 
+	// Source references are collected while writing entries and rendered as footnotes
+	var sources []sourceFootnote
+
 	// Display features by version (oldest to newest)
 	for _, version := range versions {
 		versionChanges := response.VersionChanges[version]
@@ -57,20 +110,23 @@ func (f *DefaultResponseFormatter) FormatAsText(response *domain.FeatureResponse
 		}
 
 		// Write version header
-		builder.WriteString("## Go ")
-		builder.WriteString(version)
-		builder.WriteString(" Features\n\n")
+		builder.WriteString("## " + f.tf("Go %s Features", version) + "\n\n")
 
 		// Show general changes for this version
 		if len(versionChanges) > 0 {
-			builder.WriteString("### Language & Runtime Changes\n")
+			builder.WriteString("### " + f.t("Language & Runtime Changes") + "\n")
 			for _, change := range versionChanges {
 				builder.WriteString("- **")
 				builder.WriteString(change.Category)
 				builder.WriteString("** (")
 				builder.WriteString(change.Impact)
 				builder.WriteString("): ")
-				builder.WriteString(change.Description)
+				builder.WriteString(f.localize(change.Description, change.DescriptionTranslations))
+				f.writeExperimentalLabel(&builder, change.Experimental, change.GoExperiment, change.StabilizedIn)
+				f.writeVerificationBadge(&builder, change.Verified, change.Confidence)
+				if f.detail != domain.DetailBrief {
+					sources = writeSourceMarker(&builder, sources, version, change.Source)
+				}
 				builder.WriteString("\n")
 			}
 			builder.WriteString("\n")
@@ -78,15 +134,17 @@ func (f *DefaultResponseFormatter) FormatAsText(response *domain.FeatureResponse
 
 		// Show package changes for this version
 		if len(versionPackages) > 0 {
-			builder.WriteString("### Standard Library Updates\n\n")
+			builder.WriteString("### " + f.t("Standard Library Updates") + "\n\n")
 
-			for pkg, changes := range versionPackages {
+			// Iterate packages in sorted order so output and footnote numbering are deterministic
+			for _, pkg := range slices.Sorted(maps.Keys(versionPackages)) {
+				changes := versionPackages[pkg]
 				if packageName != "" && pkg != packageName {
 					continue // Skip if filtering for specific package
 				}
 
 				if packageName == "" {
-					builder.WriteString("#### Package `")
+					builder.WriteString("#### " + f.t("Package") + " `")
 					builder.WriteString(pkg)
 					builder.WriteString("`\n")
 				}
@@ -104,10 +162,15 @@ func (f *DefaultResponseFormatter) FormatAsText(response *domain.FeatureResponse
 						builder.WriteString(change.Impact)
 						builder.WriteString(")**: ")
 					}
-					builder.WriteString(change.Description)
+					builder.WriteString(f.localize(change.Description, change.DescriptionTranslations))
+					f.writeExperimentalLabel(&builder, change.Experimental, change.GoExperiment, change.StabilizedIn)
+					f.writeVerificationBadge(&builder, change.Verified, change.Confidence)
+					if f.detail != domain.DetailBrief {
+						sources = writeSourceMarker(&builder, sources, version, change.Source)
+					}
 					builder.WriteString("\n")
 
-					if change.Example != "" {
+					if change.Example != "" && f.examples {
 						builder.WriteString("  ```go\n  ")
 						builder.WriteString(change.Example)
 						builder.WriteString("\n  ```\n")
@@ -120,15 +183,621 @@ func (f *DefaultResponseFormatter) FormatAsText(response *domain.FeatureResponse
 		builder.WriteString("\n")
 	}
 
-	builder.WriteString("## Note\n")
-	builder.WriteString("These are all the Go features available in your project version. Use them to write modern, efficient Go code.\n")
+	f.writeUpcoming(&builder, response)
+
+	f.writeSources(&builder, sources)
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("These are all the Go features available in your project version. Use them to write modern, efficient Go code.") + "\n")
 
 	return builder.String()
 }
 
+// writeUpcoming writes the features of releases after the project version in a separate section
+// The section is clearly marked so that agents never mistake future APIs for usable ones
+func (f *DefaultResponseFormatter) writeUpcoming(builder *strings.Builder, response *domain.FeatureResponse) {
+	if len(response.Upcoming) == 0 {
+		return
+	}
+
+	builder.WriteString("## " + f.t("Available If You Upgrade") + "\n")
+	builder.WriteString("> " + f.tf("**Not available in Go %s.** Do not use these features unless the project raises its go directive to the listed version.", response.ToVersion) + "\n\n")
+
+	for _, release := range response.Upcoming {
+		builder.WriteString("### " + f.tf("Upgrade to Go %s", release.Version) + "\n")
+		builder.WriteString("_" + f.t("Why:") + "_ ")
+		builder.WriteString(release.Justification)
+		builder.WriteString("\n\n")
+
+		for _, change := range release.Changes {
+			builder.WriteString("- **")
+			builder.WriteString(change.Category)
+			builder.WriteString("** (")
+			builder.WriteString(change.Impact)
+			builder.WriteString(f.tf(", requires Go %s", release.Version))
+			builder.WriteString("): ")
+			builder.WriteString(f.localize(change.Description, change.DescriptionTranslations))
+			f.writeExperimentalLabel(builder, change.Experimental, change.GoExperiment, change.StabilizedIn)
+			f.writeVerificationBadge(builder, change.Verified, change.Confidence)
+			builder.WriteString("\n")
+		}
+
+		for _, pkg := range slices.Sorted(maps.Keys(release.Packages)) {
+			for _, change := range release.Packages[pkg] {
+				builder.WriteString("- `")
+				builder.WriteString(pkg)
+				if change.Function != "" {
+					builder.WriteString(".")
+					builder.WriteString(change.Function)
+				}
+				builder.WriteString("` (")
+				builder.WriteString(change.Impact)
+				builder.WriteString(f.tf(", requires Go %s", release.Version))
+				builder.WriteString("): ")
+				builder.WriteString(f.localize(change.Description, change.DescriptionTranslations))
+				f.writeExperimentalLabel(builder, change.Experimental, change.GoExperiment, change.StabilizedIn)
+				f.writeVerificationBadge(builder, change.Verified, change.Confidence)
+				builder.WriteString("\n")
+			}
+		}
+		builder.WriteString("\n")
+	}
+}
+
+// writeExperimentalLabel labels an experimental entry with how to enable it and when it became stable
+func (f *DefaultResponseFormatter) writeExperimentalLabel(builder *strings.Builder, experimental bool, goExperiment, stabilizedIn string) {
+	if label := f.experimentalLabel(experimental, goExperiment, stabilizedIn); label != "" {
+		builder.WriteString(" _(" + label + ")_")
+	}
+}
+
+// experimentalLabel returns the text of the experimental label, or "" for stable entries
+func (f *DefaultResponseFormatter) experimentalLabel(experimental bool, goExperiment, stabilizedIn string) string {
+	if !experimental {
+		return ""
+	}
+
+	label := f.t("experimental")
+	if goExperiment != "" {
+		label += f.tf("; requires GOEXPERIMENT=%s", goExperiment)
+	}
+	if stabilizedIn != "" {
+		label += f.tf("; stable since Go %s", stabilizedIn)
+	}
+	return label
+}
+
+// writeVerificationBadge marks an unverified entry, including its confidence when known
+func (f *DefaultResponseFormatter) writeVerificationBadge(builder *strings.Builder, verified bool, confidence string) {
+	if badge := f.verificationBadge(verified, confidence); badge != "" {
+		builder.WriteString(" _(" + badge + ")_")
+	}
+}
+
+// verificationBadge returns the text of the unverified badge, or "" when no badge is shown
+func (f *DefaultResponseFormatter) verificationBadge(verified bool, confidence string) string {
+	if !f.unverifiedBadges || verified {
+		return ""
+	}
+
+	badge := f.t("unverified")
+	if confidence != "" {
+		badge += f.tf(", %s confidence", confidence)
+	}
+	return badge
+}
+
+// sourceFootnote is a source reference together with the release it documents
+type sourceFootnote struct {
+	version string
+	source  *domain.SourceRef
+}
+
+// writeSourceMarker appends a footnote marker for source, if any, and returns the updated footnote list
+func writeSourceMarker(builder *strings.Builder, sources []sourceFootnote, version string, source *domain.SourceRef) []sourceFootnote {
+	if source == nil {
+		return sources
+	}
+
+	sources = append(sources, sourceFootnote{version: version, source: source})
+	builder.WriteString(" [^")
+	builder.WriteString(strconv.Itoa(len(sources)))
+	builder.WriteString("]")
+	return sources
+}
+
+// writeSources writes the footnote definitions collected by writeSourceMarker
+func (f *DefaultResponseFormatter) writeSources(builder *strings.Builder, sources []sourceFootnote) {
+	if len(sources) == 0 {
+		return
+	}
+
+	builder.WriteString("## " + f.t("Sources") + "\n")
+	for i, footnote := range sources {
+		links := make([]string, 0, 3)
+		if url := footnote.source.ReleaseNotesURL(footnote.version); url != "" {
+			links = append(links, f.t("Release notes:")+" "+url)
+		}
+		if url := footnote.source.IssueURL(); url != "" {
+			links = append(links, f.t("Proposal:")+" "+url)
+		}
+		if url := footnote.source.PkgDocURL(); url != "" {
+			links = append(links, f.t("Docs:")+" "+url)
+		}
+
+		builder.WriteString("[^")
+		builder.WriteString(strconv.Itoa(i + 1))
+		builder.WriteString("]: ")
+		builder.WriteString(strings.Join(links, " | "))
+		builder.WriteString("\n")
+	}
+	builder.WriteString("\n")
+}
+
 // sortVersions sorts versions using the version comparator with modern slices
 func (f *DefaultResponseFormatter) sortVersions(versions []string) {
 	slices.SortFunc(versions, func(a, b string) int {
 		return f.comparator.Compare(a, b)
 	})
 }
+
+// FormatMigrationGuide formats a MigrationGuide as a step-by-step Markdown document
+func (f *DefaultResponseFormatter) FormatMigrationGuide(guide *domain.MigrationGuide) string {
+	summary := f.serviceText(guide.Summary, func(p sprintf) string { return migrationSummary(p, guide) })
+	if len(guide.Steps) == 0 {
+		return "# " + f.t("No Migration Steps Found") + "\n\n" + summary + "."
+	}
+
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	// Write header
+	builder.WriteString("# " + f.tf("Go Migration Guide (Go %s → Go %s)", guide.FromVersion, guide.ToVersion) + "\n\n")
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(summary)
+	builder.WriteString("\n\n")
+
+	// Steps are already ordered chronologically by the service
+	currentVersion := ""
+	for i, step := range guide.Steps {
+		if step.Version != currentVersion {
+			currentVersion = step.Version
+			builder.WriteString("## Go ")
+			builder.WriteString(step.Version)
+			builder.WriteString("\n\n")
+		}
+
+		builder.WriteString("### " + f.tf("Step %d", i+1) + ": ")
+		if step.Package != "" {
+			builder.WriteString(f.t("Package") + " `")
+			builder.WriteString(step.Package)
+			builder.WriteString("`")
+			if step.Symbol != "" {
+				builder.WriteString(" `")
+				builder.WriteString(step.Symbol)
+				builder.WriteString("`")
+			}
+		} else {
+			builder.WriteString(step.Category)
+		}
+		builder.WriteString(" (")
+		builder.WriteString(step.Impact)
+		builder.WriteString(")\n")
+		builder.WriteString(step.Description)
+		builder.WriteString("\n\n")
+
+		if step.MigrationNotes != "" {
+			builder.WriteString("- **" + f.t("Migration notes") + "**: ")
+			builder.WriteString(step.MigrationNotes)
+			builder.WriteString("\n")
+		}
+		builder.WriteString("- **" + f.t("What to check") + "**: ")
+		builder.WriteString(f.serviceText(step.WhatToCheck, func(p sprintf) string { return stepCheck(p, step) }))
+		builder.WriteString("\n\n")
+	}
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("Upgrade one version at a time where possible and rerun your tests after each step.") + "\n")
+
+	return builder.String()
+}
+
+// FormatGodebugReport formats a GodebugReport as LLM-readable Markdown text
+func (f *DefaultResponseFormatter) FormatGodebugReport(report *domain.GodebugReport) string {
+	if len(report.Settings) == 0 {
+		return "# " + f.t("No GODEBUG Settings Found") + "\n\n" + f.tf("No GODEBUG settings are recorded for go %s.", report.GoVersion)
+	}
+
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	// Write header
+	builder.WriteString("# " + f.tf("GODEBUG Defaults (go %s)", report.GoVersion) + "\n\n")
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(f.serviceText(report.Summary, func(p sprintf) string { return godebugSummary(p, report) }))
+	builder.WriteString("\n\n")
+
+	if report.CompareTo != "" {
+		builder.WriteString("## " + f.tf("Changed Defaults (go %s → go %s)", report.CompareTo, report.GoVersion) + "\n")
+
+		if len(report.Changes) == 0 {
+			builder.WriteString(f.t("No GODEBUG defaults differ between these versions.") + "\n")
+		}
+		for _, change := range report.Changes {
+			builder.WriteString("- **`")
+			builder.WriteString(change.Name)
+			builder.WriteString("`**: `")
+			builder.WriteString(change.From)
+			builder.WriteString("` → `")
+			builder.WriteString(change.To)
+			builder.WriteString("`")
+			if change.ChangedIn != "" {
+				builder.WriteString(" (" + f.tf("changed in Go %s", change.ChangedIn) + ")")
+			}
+			builder.WriteString(": ")
+			builder.WriteString(change.Description)
+			builder.WriteString("\n")
+		}
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("## " + f.t("Effective Defaults") + "\n")
+	for _, setting := range report.Settings {
+		builder.WriteString("- **`")
+		builder.WriteString(setting.Name)
+		builder.WriteString("=")
+		builder.WriteString(setting.Default)
+		builder.WriteString("`** (" + f.tf("introduced in Go %s", setting.IntroducedIn))
+		if setting.DefaultSince != "" && setting.DefaultSince != setting.IntroducedIn {
+			builder.WriteString(f.tf(", default since Go %s", setting.DefaultSince))
+		}
+		builder.WriteString("): ")
+		builder.WriteString(setting.Description)
+		builder.WriteString("\n")
+	}
+	builder.WriteString("\n")
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("Override a default with a `//go:debug name=value` directive in the main package, a `godebug` block in go.mod (Go 1.23+), or the GODEBUG environment variable.") + "\n")
+
+	return builder.String()
+}
+
+// FormatDeprecationReport formats a DeprecationReport as LLM-readable Markdown text
+func (f *DefaultResponseFormatter) FormatDeprecationReport(report *domain.DeprecationReport) string {
+	var builder strings.Builder
+	builder.Grow(1024)
+
+	// Write header
+	if report.Symbol != "" {
+		builder.WriteString("# " + f.tf("Deprecation Lookup: `%s`", report.Symbol) + "\n\n")
+	} else {
+		builder.WriteString("# " + f.tf("Go Deprecations (Go %s)", report.Version) + "\n\n")
+	}
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(f.serviceText(report.Summary, func(p sprintf) string { return deprecationSummary(p, report) }))
+	builder.WriteString("\n\n")
+
+	// Records are already ordered chronologically by the service
+	currentVersion := ""
+	for _, record := range report.Deprecations {
+		if record.DeprecatedIn != currentVersion {
+			if currentVersion != "" {
+				builder.WriteString("\n")
+			}
+			currentVersion = record.DeprecatedIn
+			builder.WriteString("## " + f.tf("Deprecated in Go %s", record.DeprecatedIn) + "\n")
+		}
+
+		builder.WriteString("- **`")
+		builder.WriteString(record.Symbol)
+		builder.WriteString("`**")
+		if record.Replacement != "" {
+			builder.WriteString(" → ")
+			builder.WriteString(record.Replacement)
+		}
+		builder.WriteString(": ")
+		builder.WriteString(record.Description)
+		builder.WriteString("\n")
+	}
+	if len(report.Deprecations) > 0 {
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("Prefer the replacements in new code; go vet and staticcheck (SA1019) report uses of deprecated identifiers.") + "\n")
+
+	return builder.String()
+}
+
+// FormatPlatformReport formats a PlatformReport as LLM-readable Markdown text
+func (f *DefaultResponseFormatter) FormatPlatformReport(report *domain.PlatformReport) string {
+	var builder strings.Builder
+	builder.Grow(1024)
+
+	// Write header
+	builder.WriteString("# " + f.t("Go Platform Support") + " (")
+	if report.GOOS != "" {
+		builder.WriteString(report.GOOS)
+		if report.GOARCH != "" {
+			builder.WriteString("/")
+			builder.WriteString(report.GOARCH)
+		}
+		builder.WriteString(", ")
+	}
+	builder.WriteString("Go ")
+	builder.WriteString(report.Version)
+	builder.WriteString(")\n\n")
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(f.serviceText(report.Summary, func(p sprintf) string { return platformSummary(p, report) }))
+	builder.WriteString("\n\n")
+
+	if len(report.Ports) > 0 {
+		builder.WriteString("## " + f.t("Ports") + "\n")
+		for _, port := range report.Ports {
+			builder.WriteString("- **`")
+			builder.WriteString(port.GOOS)
+			if port.GOARCH != "" {
+				builder.WriteString("/")
+				builder.WriteString(port.GOARCH)
+			} else {
+				builder.WriteString("/*")
+			}
+			builder.WriteString("`**: ")
+			builder.WriteString(port.Status)
+			builder.WriteString(f.tf(" since Go %s", port.Since))
+			builder.WriteString(" — ")
+			builder.WriteString(port.Description)
+			builder.WriteString("\n")
+		}
+		builder.WriteString("\n")
+	}
+
+	if len(report.Requirements) > 0 {
+		builder.WriteString("## " + f.t("Minimum OS Versions") + "\n")
+		for _, requirement := range report.Requirements {
+			builder.WriteString("- **")
+			builder.WriteString(requirement.GOOS)
+			builder.WriteString("**: ")
+			builder.WriteString(requirement.MinOSVersion)
+			builder.WriteString(f.tf(" or later (since Go %s)", requirement.Since) + " — ")
+			builder.WriteString(requirement.Description)
+			builder.WriteString("\n")
+		}
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("Only ports and requirements that changed in the recorded releases are listed; long-standing first-class ports such as linux/amd64 are supported throughout.") + "\n")
+
+	return builder.String()
+}
+
+// FormatToolchainReport formats a ToolchainReport as LLM-readable Markdown text grouped by command
+func (f *DefaultResponseFormatter) FormatToolchainReport(report *domain.ToolchainReport) string {
+	summary := f.serviceText(report.Summary, func(p sprintf) string { return toolchainSummary(p, report) })
+	if len(report.Entries) == 0 {
+		return "# " + f.t("No Toolchain Changes Found") + "\n\n" + summary + "."
+	}
+
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	// Write header
+	builder.WriteString("# " + f.tf("Go Toolchain Updates (Go %s)", report.Version) + "\n\n")
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(summary)
+	builder.WriteString("\n\n")
+
+	// Group by command in sorted order; entries stay chronological within a group
+	commands := make([]string, 0)
+	grouped := make(map[string][]domain.ToolchainEntry)
+	for _, entry := range report.Entries {
+		if _, exists := grouped[entry.Command]; !exists {
+			commands = append(commands, entry.Command)
+		}
+		grouped[entry.Command] = append(grouped[entry.Command], entry)
+	}
+	slices.Sort(commands)
+
+	for _, command := range commands {
+		builder.WriteString("## `")
+		builder.WriteString(command)
+		builder.WriteString("`\n")
+
+		for _, entry := range grouped[command] {
+			builder.WriteString("- ")
+			if entry.Flag != "" {
+				builder.WriteString("**`")
+				builder.WriteString(entry.Flag)
+				builder.WriteString("`** ")
+			}
+			if entry.EnvVar != "" {
+				builder.WriteString("**`")
+				builder.WriteString(entry.EnvVar)
+				builder.WriteString("`** ")
+			}
+			builder.WriteString("(")
+			builder.WriteString(entry.Status)
+			builder.WriteString(f.tf(" in Go %s", entry.Version))
+			builder.WriteString("): ")
+			builder.WriteString(entry.Description)
+			builder.WriteString("\n")
+		}
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("Everything listed is available in your Go version; entries marked removed or deprecated should no longer be used in scripts.") + "\n")
+
+	return builder.String()
+}
+
+// FormatVersionComparison formats a VersionComparison as LLM-readable Markdown tables
+func (f *DefaultResponseFormatter) FormatVersionComparison(comparison *domain.VersionComparison) string {
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	from := "Go " + comparison.FromVersion
+	to := "Go " + comparison.ToVersion
+
+	// Write header
+	builder.WriteString("# " + f.tf("Go Version Comparison (%s vs %s)", from, to) + "\n\n")
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(f.serviceText(comparison.Summary, func(p sprintf) string { return comparisonSummary(p, comparison) }))
+	builder.WriteString("\n\n")
+
+	builder.WriteString("## " + f.t("Overview") + "\n")
+	overview := newMarkdownTable("", from, to, f.t("Difference")).alignRight(1, 2, 3)
+	addTotalsRow(overview, f.t("Language changes"), comparison.From.LanguageChanges, comparison.To.LanguageChanges)
+	addTotalsRow(overview, f.t("Breaking changes"), comparison.From.BreakingChanges, comparison.To.BreakingChanges)
+	addTotalsRow(overview, f.t("New APIs"), comparison.From.NewAPIs, comparison.To.NewAPIs)
+	addTotalsRow(overview, f.t("Packages touched"), comparison.From.PackagesTouched, comparison.To.PackagesTouched)
+	overview.writeTo(&builder)
+
+	builder.WriteString("## " + f.tf("Packages Changed After %s", from) + "\n")
+	if len(comparison.Packages) == 0 {
+		builder.WriteString(f.t("No package changes between the two versions.") + "\n\n")
+	} else {
+		packages := newMarkdownTable(f.t("Package"), f.tf("New APIs (%s)", from), f.tf("New APIs (%s)", to), f.t("Changed in")).alignRight(1, 2)
+		for _, pkg := range comparison.Packages {
+			packages.addRow(
+				"`"+pkg.Package+"`",
+				strconv.Itoa(pkg.FromNewAPIs),
+				strconv.Itoa(pkg.ToNewAPIs),
+				strings.Join(pkg.ChangedIn, ", "),
+			)
+		}
+		packages.writeTo(&builder)
+	}
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("Counts are cumulative from the oldest supported release; use go-updates with a package filter for the individual APIs.") + "\n")
+
+	return builder.String()
+}
+
+// addTotalsRow adds an overview row with both counts and their signed difference
+func addTotalsRow(table *markdownTable, label string, from, to int) {
+	difference := strconv.Itoa(to - from)
+	if to > from {
+		difference = "+" + difference
+	}
+	table.addRow(label, strconv.Itoa(from), strconv.Itoa(to), difference)
+}
+
+// FormatPackageHistory formats a PackageHistory as an LLM-readable Markdown timeline
+func (f *DefaultResponseFormatter) FormatPackageHistory(history *domain.PackageHistory) string {
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	// Write header
+	builder.WriteString("# " + f.tf("Package History: `%s`", history.Package))
+	if history.Version != "" {
+		builder.WriteString(" (Go " + history.Version + ")")
+	}
+	builder.WriteString("\n\n")
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(f.serviceText(history.Summary, func(p sprintf) string { return packageHistorySummary(p, history) }))
+	builder.WriteString("\n\n")
+
+	// Entries are already ordered chronologically by the service
+	for _, entry := range history.Entries {
+		builder.WriteString("## Go ")
+		builder.WriteString(entry.Version)
+		if !entry.Available {
+			builder.WriteString(" (" + f.t("not yet available") + ")\n")
+			builder.WriteString("> " + f.tf("Requires upgrading to Go %s or later; do not use these APIs in a Go %s project.", entry.Version, history.Version) + "\n\n")
+		} else {
+			builder.WriteString("\n")
+		}
+
+		for _, change := range entry.Changes {
+			builder.WriteString("- ")
+			if change.Function != "" {
+				builder.WriteString("**`")
+				builder.WriteString(change.Function)
+				builder.WriteString("`** (")
+				builder.WriteString(change.Impact)
+				builder.WriteString("): ")
+			} else {
+				builder.WriteString("**(")
+				builder.WriteString(change.Impact)
+				builder.WriteString(")**: ")
+			}
+			builder.WriteString(f.localize(change.Description, change.DescriptionTranslations))
+			f.writeExperimentalLabel(&builder, change.Experimental, change.GoExperiment, change.StabilizedIn)
+			f.writeVerificationBadge(&builder, change.Verified, change.Confidence)
+			builder.WriteString("\n")
+		}
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	if history.Version != "" {
+		builder.WriteString(f.tf("Only releases up to Go %s can be used as-is; suggest upgrading when a needed API is marked not yet available.", history.Version) + "\n")
+	} else {
+		builder.WriteString(f.t("Pass your project's Go version to see which of these changes you can use.") + "\n")
+	}
+
+	return builder.String()
+}
+
+// FormatVersionCatalog formats a VersionCatalog as a table of releases followed by their summaries
+func (f *DefaultResponseFormatter) FormatVersionCatalog(catalog *domain.VersionCatalog) string {
+	var builder strings.Builder
+	builder.Grow(2048)
+
+	// Write header
+	builder.WriteString("# " + f.t("Supported Go Versions") + "\n\n")
+
+	// Write summary
+	builder.WriteString("## " + f.t("Summary") + "\n")
+	builder.WriteString(f.serviceText(catalog.Summary, func(p sprintf) string { return catalogSummary(p, catalog) }))
+	builder.WriteString("\n\n")
+
+	builder.WriteString("## " + f.t("Releases") + "\n")
+	releases := newMarkdownTable(f.t("Version"), f.t("Released"), f.t("Language changes"), f.t("Breaking changes"), f.t("New APIs"), f.t("Packages touched")).alignRight(2, 3, 4, 5)
+	for _, release := range catalog.Releases {
+		released := ""
+		if !release.ReleaseDate.IsZero() {
+			released = release.ReleaseDate.Format("2006-01-02")
+		}
+		releases.addRow(
+			"Go "+release.Version,
+			released,
+			strconv.Itoa(release.Counts.LanguageChanges),
+			strconv.Itoa(release.Counts.BreakingChanges),
+			strconv.Itoa(release.Counts.NewAPIs),
+			strconv.Itoa(release.Counts.PackagesTouched),
+		)
+	}
+	releases.writeTo(&builder)
+
+	builder.WriteString("## " + f.t("Highlights") + "\n")
+	for _, release := range catalog.Releases {
+		builder.WriteString("- **Go " + release.Version + "**: ")
+		builder.WriteString(f.localize(release.Summary, release.SummaryTranslations))
+		builder.WriteString("\n")
+	}
+	builder.WriteString("\n")
+
+	builder.WriteString("## " + f.t("Note") + "\n")
+	builder.WriteString(f.t("Counts cover the changes made by each release alone; pass any listed version to the other tools.") + "\n")
+
+	return builder.String()
+}
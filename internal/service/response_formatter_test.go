@@ -1,7 +1,9 @@
 package service
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/tenkoh/recent-go-mcp/internal/domain"
 	"github.com/tenkoh/recent-go-mcp/internal/version"
@@ -322,4 +324,559 @@ These are all the Go features available in your project version. Use them to wri
 			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
 		}
 	})
+
+	t.Run("source footnotes", func(t *testing.T) {
+		routing := &domain.SourceRef{Anchor: "enhanced_routing_patterns", Issue: 61410, PkgDoc: "net/http#ServeMux"}
+		loopvar := &domain.SourceRef{Anchor: "language", Issue: 60078}
+		response := &domain.FeatureResponse{
+			ToVersion: "1.22",
+			Summary:   "Entries with provenance",
+			Changes: []domain.Change{
+				{Category: "language", Description: "per-iteration loop variables", Impact: "breaking", Source: loopvar},
+			},
+			PackageInfo: map[string][]domain.PackageChange{
+				"net/http": {
+					{Function: "ServeMux", Description: "enhanced routing", Impact: "enhancement", Source: routing},
+				},
+			},
+			VersionChanges: map[string][]domain.Change{
+				"1.22": {{Category: "language", Description: "per-iteration loop variables", Impact: "breaking", Source: loopvar}},
+			},
+			VersionPackages: map[string]map[string][]domain.PackageChange{
+				"1.22": {
+					"net/http": {
+						{Function: "ServeMux", Description: "enhanced routing", Impact: "enhancement", Source: routing},
+					},
+				},
+			},
+		}
+
+		result := formatter.FormatAsText(response, "1.22", "")
+
+		expected := `# Go Features Available (Go 1.22)
+
+## Summary
+Entries with provenance
+
+## Go 1.22 Features
+
+### Language & Runtime Changes
+- **language** (breaking): per-iteration loop variables [^1]
+
+### Standard Library Updates
+
+#### Package ` + "`net/http`" + `
+- **` + "`ServeMux`" + `** (enhancement): enhanced routing [^2]
+
+
+## Sources
+[^1]: Release notes: https://go.dev/doc/go1.22#language | Proposal: https://go.dev/issue/60078
+[^2]: Release notes: https://go.dev/doc/go1.22#enhanced_routing_patterns | Proposal: https://go.dev/issue/61410 | Docs: https://pkg.go.dev/net/http#ServeMux
+
+## Note
+These are all the Go features available in your project version. Use them to write modern, efficient Go code.
+`
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("brief detail level", func(t *testing.T) {
+		briefFormatter := NewResponseFormatter(version.NewSemanticVersionComparator(), WithDetailLevel(domain.DetailBrief))
+		routing := &domain.SourceRef{Anchor: "enhanced_routing_patterns", Issue: 61410}
+		response := &domain.FeatureResponse{
+			ToVersion: "1.22",
+			Summary:   "Brief listing",
+			PackageInfo: map[string][]domain.PackageChange{
+				"net/http": {
+					{Function: "ServeMux", Description: "enhanced routing", Impact: "enhancement", Example: `mux.HandleFunc("GET /items/{id}", h)`, Source: routing},
+				},
+			},
+			VersionChanges: map[string][]domain.Change{"1.22": {}},
+			VersionPackages: map[string]map[string][]domain.PackageChange{
+				"1.22": {
+					"net/http": {
+						{Function: "ServeMux", Description: "enhanced routing", Impact: "enhancement", Example: `mux.HandleFunc("GET /items/{id}", h)`, Source: routing},
+					},
+				},
+			},
+		}
+
+		result := briefFormatter.FormatAsText(response, "1.22", "")
+
+		expected := `# Go Features Available (Go 1.22)
+
+## Summary
+Brief listing
+
+## Go 1.22 Features
+
+### Standard Library Updates
+
+#### Package ` + "`net/http`" + `
+- **` + "`ServeMux`" + `** (enhancement): enhanced routing
+
+
+## Note
+These are all the Go features available in your project version. Use them to write modern, efficient Go code.
+`
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("unverified badges", func(t *testing.T) {
+		badgeFormatter := NewResponseFormatter(version.NewSemanticVersionComparator(), WithUnverifiedBadges())
+		response := &domain.FeatureResponse{
+			ToVersion: "1.22",
+			Summary:   "Mixed verification",
+			Changes: []domain.Change{
+				{Category: "language", Description: "range over int", Impact: "new", Verification: domain.Verification{Verified: true}},
+				{Category: "runtime", Description: "faster GC", Impact: "performance", Confidence: "medium"},
+			},
+			PackageInfo: map[string][]domain.PackageChange{
+				"slices": {
+					{Function: "Concat", Description: "concatenates slices", Impact: "new"},
+				},
+			},
+			VersionChanges: map[string][]domain.Change{
+				"1.22": {
+					{Category: "language", Description: "range over int", Impact: "new", Verification: domain.Verification{Verified: true}},
+					{Category: "runtime", Description: "faster GC", Impact: "performance", Confidence: "medium"},
+				},
+			},
+			VersionPackages: map[string]map[string][]domain.PackageChange{
+				"1.22": {
+					"slices": {
+						{Function: "Concat", Description: "concatenates slices", Impact: "new"},
+					},
+				},
+			},
+		}
+
+		result := badgeFormatter.FormatAsText(response, "1.22", "")
+
+		expected := `# Go Features Available (Go 1.22)
+
+## Summary
+Mixed verification
+
+## Go 1.22 Features
+
+### Language & Runtime Changes
+- **language** (new): range over int
+- **runtime** (performance): faster GC _(unverified, medium confidence)_
+
+### Standard Library Updates
+
+#### Package ` + "`slices`" + `
+- **` + "`Concat`" + `** (new): concatenates slices _(unverified)_
+
+
+## Note
+These are all the Go features available in your project version. Use them to write modern, efficient Go code.
+`
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+
+		// Upcoming entries carry the same badge
+		response.Upcoming = []domain.UpcomingRelease{{
+			Version:       "1.23",
+			Justification: "iterators",
+			Changes:       []domain.Change{{Category: "language", Description: "range over func", Impact: "new", Confidence: "high"}},
+			Packages:      map[string][]domain.PackageChange{"iter": {{Function: "Seq", Description: "iterator type", Impact: "new", Verification: domain.Verification{Verified: true}}}},
+		}}
+		result = badgeFormatter.FormatAsText(response, "1.22", "")
+		if !strings.Contains(result, "- **language** (new, requires Go 1.23): range over func _(unverified, high confidence)_\n") ||
+			!strings.Contains(result, "- `iter.Seq` (new, requires Go 1.23): iterator type\n") {
+			t.Errorf("Expected badges on unverified upcoming entries only, got:\n%s", result)
+		}
+	})
+
+	t.Run("upcoming releases", func(t *testing.T) {
+		response := &domain.FeatureResponse{
+			ToVersion: "1.21",
+			Summary:   "Features with upcoming releases",
+			Changes: []domain.Change{
+				{Category: "language", Description: "min and max", Impact: "new"},
+			},
+			PackageInfo: map[string][]domain.PackageChange{},
+			VersionChanges: map[string][]domain.Change{
+				"1.21": {{Category: "language", Description: "min and max", Impact: "new"}},
+			},
+			VersionPackages: map[string]map[string][]domain.PackageChange{
+				"1.21": {},
+			},
+			Upcoming: []domain.UpcomingRelease{
+				{
+					Version:       "1.22",
+					Justification: "Range over integers (1 language changes, 1 new APIs)",
+					Changes:       []domain.Change{{Category: "language", Description: "range over int", Impact: "new"}},
+					Packages: map[string][]domain.PackageChange{
+						"slices": {{Function: "Concat", Description: "concatenates slices", Impact: "new"}},
+					},
+				},
+			},
+		}
+
+		result := formatter.FormatAsText(response, "1.21", "")
+
+		expected := `# Go Features Available (Go 1.21)
+
+## Summary
+Features with upcoming releases
+
+## Go 1.21 Features
+
+### Language & Runtime Changes
+- **language** (new): min and max
+
+
+## Available If You Upgrade
+> **Not available in Go 1.21.** Do not use these features unless the project raises its go directive to the listed version.
+
+### Upgrade to Go 1.22
+_Why:_ Range over integers (1 language changes, 1 new APIs)
+
+- **language** (new, requires Go 1.22): range over int
+- ` + "`slices.Concat`" + ` (new, requires Go 1.22): concatenates slices
+
+## Note
+These are all the Go features available in your project version. Use them to write modern, efficient Go code.
+`
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("experimental labels", func(t *testing.T) {
+		rangefunc := domain.Change{Category: "language", Description: "range over func", Impact: "new", Experimental: true, GoExperiment: "rangefunc", StabilizedIn: "1.23"}
+		wasi := domain.Change{Category: "platform", Description: "WASI port", Impact: "new", Experimental: true}
+		response := &domain.FeatureResponse{
+			ToVersion:       "1.22",
+			Summary:         "Experimental features",
+			Changes:         []domain.Change{rangefunc, wasi},
+			PackageInfo:     map[string][]domain.PackageChange{},
+			VersionChanges:  map[string][]domain.Change{"1.22": {rangefunc, wasi}},
+			VersionPackages: map[string]map[string][]domain.PackageChange{"1.22": {}},
+		}
+
+		result := formatter.FormatAsText(response, "1.22", "")
+
+		expected := `# Go Features Available (Go 1.22)
+
+## Summary
+Experimental features
+
+## Go 1.22 Features
+
+### Language & Runtime Changes
+- **language** (new): range over func _(experimental; requires GOEXPERIMENT=rangefunc; stable since Go 1.23)_
+- **platform** (new): WASI port _(experimental)_
+
+
+## Note
+These are all the Go features available in your project version. Use them to write modern, efficient Go code.
+`
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+}
+
+func TestResponseFormatter_FormatMigrationGuide(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator())
+
+	t.Run("empty guide", func(t *testing.T) {
+		guide := &domain.MigrationGuide{
+			FromVersion: "1.19",
+			ToVersion:   "1.20",
+			Summary:     "No breaking changes or deprecations between Go 1.19 and Go 1.20",
+		}
+
+		result := formatter.FormatMigrationGuide(guide)
+		expected := "# No Migration Steps Found\n\nNo breaking changes or deprecations between Go 1.19 and Go 1.20."
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+
+	t.Run("steps grouped by version", func(t *testing.T) {
+		guide := &domain.MigrationGuide{
+			FromVersion: "1.21",
+			ToVersion:   "1.22",
+			Summary:     "2 breaking changes and 0 deprecations to review when upgrading from Go 1.21 to Go 1.22",
+			Steps: []domain.MigrationStep{
+				{Version: "1.22", Category: "language", Impact: "breaking", Description: "Loop variable semantics", MigrationNotes: "Raise the go directive", WhatToCheck: "Rerun tests"},
+				{Version: "1.22", Category: "library", Package: "net/http", Symbol: "ServeMux", Impact: "breaking", Description: "New patterns", WhatToCheck: "Audit patterns"},
+			},
+		}
+
+		result := formatter.FormatMigrationGuide(guide)
+
+		expected := `# Go Migration Guide (Go 1.21 → Go 1.22)
+
+## Summary
+2 breaking changes and 0 deprecations to review when upgrading from Go 1.21 to Go 1.22
+
+## Go 1.22
+
+### Step 1: language (breaking)
+Loop variable semantics
+
+- **Migration notes**: Raise the go directive
+- **What to check**: Rerun tests
+
+### Step 2: Package ` + "`net/http` `ServeMux`" + ` (breaking)
+New patterns
+
+- **What to check**: Audit patterns
+
+## Note
+Upgrade one version at a time where possible and rerun your tests after each step.
+`
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
+}
+
+func TestResponseFormatter_FormatGodebugReport(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator())
+
+	report := &domain.GodebugReport{
+		GoVersion: "1.22",
+		CompareTo: "1.20",
+		Summary:   "1 GODEBUG settings apply to modules declaring go 1.22; 1 defaults differ from go 1.20",
+		Settings: []domain.GodebugValue{
+			{Name: "panicnil", Default: "0", IntroducedIn: "1.21", DefaultSince: "1.21", Description: "panic(nil) is a run-time error"},
+		},
+		Changes: []domain.GodebugChange{
+			{Name: "panicnil", From: "1", To: "0", ChangedIn: "1.21", Description: "panic(nil) is a run-time error"},
+		},
+	}
+
+	result := formatter.FormatGodebugReport(report)
+
+	expected := "# GODEBUG Defaults (go 1.22)\n\n" +
+		"## Summary\n1 GODEBUG settings apply to modules declaring go 1.22; 1 defaults differ from go 1.20\n\n" +
+		"## Changed Defaults (go 1.20 → go 1.22)\n" +
+		"- **`panicnil`**: `1` → `0` (changed in Go 1.21): panic(nil) is a run-time error\n\n" +
+		"## Effective Defaults\n" +
+		"- **`panicnil=0`** (introduced in Go 1.21): panic(nil) is a run-time error\n\n" +
+		"## Note\nOverride a default with a `//go:debug name=value` directive in the main package, a `godebug` block in go.mod (Go 1.23+), or the GODEBUG environment variable.\n"
+
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestResponseFormatter_FormatDeprecationReport(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator())
+
+	report := &domain.DeprecationReport{
+		Version: "1.20",
+		Summary: "2 deprecated APIs to avoid in your Go 1.20 project",
+		Deprecations: []domain.DeprecationRecord{
+			{Symbol: "io/ioutil.ReadAll", Package: "io/ioutil", DeprecatedIn: "1.16", Replacement: "io.ReadAll", Description: "Reads until EOF"},
+			{Symbol: "reflect.PtrTo", Package: "reflect", DeprecatedIn: "1.20", Description: "Use PointerTo"},
+		},
+	}
+
+	result := formatter.FormatDeprecationReport(report)
+
+	expected := "# Go Deprecations (Go 1.20)\n\n" +
+		"## Summary\n2 deprecated APIs to avoid in your Go 1.20 project\n\n" +
+		"## Deprecated in Go 1.16\n" +
+		"- **`io/ioutil.ReadAll`** → io.ReadAll: Reads until EOF\n\n" +
+		"## Deprecated in Go 1.20\n" +
+		"- **`reflect.PtrTo`**: Use PointerTo\n\n" +
+		"## Note\nPrefer the replacements in new code; go vet and staticcheck (SA1019) report uses of deprecated identifiers.\n"
+
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestResponseFormatter_FormatPlatformReport(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator())
+
+	report := &domain.PlatformReport{
+		Version: "1.23",
+		GOOS:    "darwin",
+		Summary: "Go 1.23 requires darwin 11 or later (since Go 1.23)",
+		Ports: []domain.PortStatus{
+			{GOOS: "darwin", GOARCH: "arm64", Status: "supported", Since: "1.16", Description: "Apple silicon"},
+		},
+		Requirements: []domain.OSRequirement{
+			{GOOS: "darwin", MinOSVersion: "11", Since: "1.23", Description: "Requires Big Sur"},
+		},
+	}
+
+	result := formatter.FormatPlatformReport(report)
+
+	expected := "# Go Platform Support (darwin, Go 1.23)\n\n" +
+		"## Summary\nGo 1.23 requires darwin 11 or later (since Go 1.23)\n\n" +
+		"## Ports\n- **`darwin/arm64`**: supported since Go 1.16 — Apple silicon\n\n" +
+		"## Minimum OS Versions\n- **darwin**: 11 or later (since Go 1.23) — Requires Big Sur\n\n" +
+		"## Note\nOnly ports and requirements that changed in the recorded releases are listed; long-standing first-class ports such as linux/amd64 are supported throughout.\n"
+
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestResponseFormatter_FormatToolchainReport(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator())
+
+	report := &domain.ToolchainReport{
+		Version: "1.21",
+		Summary: "2 toolchain changes for the go command available in Go 1.21",
+		Entries: []domain.ToolchainEntry{
+			{ToolchainChange: domain.ToolchainChange{Command: "go test", Flag: "-fuzz", Status: "added", Description: "Fuzzing"}, Version: "1.18"},
+			{ToolchainChange: domain.ToolchainChange{Command: "go", EnvVar: "GOTOOLCHAIN", Status: "added", Description: "Toolchain selection"}, Version: "1.21"},
+		},
+	}
+
+	result := formatter.FormatToolchainReport(report)
+
+	expected := "# Go Toolchain Updates (Go 1.21)\n\n" +
+		"## Summary\n2 toolchain changes for the go command available in Go 1.21\n\n" +
+		"## `go`\n- **`GOTOOLCHAIN`** (added in Go 1.21): Toolchain selection\n\n" +
+		"## `go test`\n- **`-fuzz`** (added in Go 1.18): Fuzzing\n\n" +
+		"## Note\nEverything listed is available in your Go version; entries marked removed or deprecated should no longer be used in scripts.\n"
+
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestResponseFormatter_FormatVersionComparison(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator())
+
+	comparison := &domain.VersionComparison{
+		FromVersion: "1.21",
+		ToVersion:   "1.22",
+		Summary:     "Go 1.22 adds 1 new APIs, 1 language changes and 1 breaking changes over Go 1.21, touching 1 packages",
+		From:        domain.ComparisonTotals{LanguageChanges: 3, BreakingChanges: 0, NewAPIs: 10, PackagesTouched: 4},
+		To:          domain.ComparisonTotals{LanguageChanges: 4, BreakingChanges: 1, NewAPIs: 11, PackagesTouched: 4},
+		Packages: []domain.PackageComparison{
+			{Package: "slices", FromNewAPIs: 10, ToNewAPIs: 11, ChangedIn: []string{"1.22"}},
+		},
+	}
+
+	result := formatter.FormatVersionComparison(comparison)
+
+	expected := "# Go Version Comparison (Go 1.21 vs Go 1.22)\n\n" +
+		"## Summary\nGo 1.22 adds 1 new APIs, 1 language changes and 1 breaking changes over Go 1.21, touching 1 packages\n\n" +
+		"## Overview\n" +
+		"|  | Go 1.21 | Go 1.22 | Difference |\n" +
+		"| --- | ---: | ---: | ---: |\n" +
+		"| Language changes | 3 | 4 | +1 |\n" +
+		"| Breaking changes | 0 | 1 | +1 |\n" +
+		"| New APIs | 10 | 11 | +1 |\n" +
+		"| Packages touched | 4 | 4 | 0 |\n\n" +
+		"## Packages Changed After Go 1.21\n" +
+		"| Package | New APIs (Go 1.21) | New APIs (Go 1.22) | Changed in |\n" +
+		"| --- | ---: | ---: | --- |\n" +
+		"| `slices` | 10 | 11 | 1.22 |\n\n" +
+		"## Note\nCounts are cumulative from the oldest supported release; use go-updates with a package filter for the individual APIs.\n"
+
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestResponseFormatter_FormatPackageHistory(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator(), WithUnverifiedBadges())
+
+	history := &domain.PackageHistory{
+		Package: "slices",
+		Version: "1.22",
+		Summary: "Package 'slices' changed in 2 releases; 1 of them are newer than Go 1.22 and not yet available",
+		Entries: []domain.PackageHistoryEntry{
+			{Version: "1.21", Available: true, Changes: []domain.PackageChange{{Function: "Sort", Description: "sorts a slice", Impact: "new", Verification: domain.Verification{Verified: true}}}},
+			{Version: "1.23", Available: false, Changes: []domain.PackageChange{{Function: "Collect", Description: "collects an iterator", Impact: "new", Experimental: true, GoExperiment: "rangefunc", Confidence: "medium"}}},
+		},
+	}
+
+	result := formatter.FormatPackageHistory(history)
+
+	expected := "# Package History: `slices` (Go 1.22)\n\n" +
+		"## Summary\nPackage 'slices' changed in 2 releases; 1 of them are newer than Go 1.22 and not yet available\n\n" +
+		"## Go 1.21\n- **`Sort`** (new): sorts a slice\n\n" +
+		"## Go 1.23 (not yet available)\n" +
+		"> Requires upgrading to Go 1.23 or later; do not use these APIs in a Go 1.22 project.\n\n" +
+		"- **`Collect`** (new): collects an iterator _(experimental; requires GOEXPERIMENT=rangefunc)_ _(unverified, medium confidence)_\n\n" +
+		"## Note\nOnly releases up to Go 1.22 can be used as-is; suggest upgrading when a needed API is marked not yet available.\n"
+
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+}
+
+func TestResponseFormatter_FormatVersionCatalog(t *testing.T) {
+	formatter := NewResponseFormatter(version.NewSemanticVersionComparator())
+
+	catalog := &domain.VersionCatalog{
+		OldestVersion: "1.22",
+		LatestVersion: "1.23",
+		Summary:       "Go 1.22 through 1.23 (2 releases) are available",
+		Releases: []domain.ReleaseOverview{
+			{
+				Version:     "1.23",
+				ReleaseDate: time.Date(2024, 8, 13, 0, 0, 0, 0, time.UTC),
+				Summary:     "Iterators",
+				Counts:      domain.ComparisonTotals{LanguageChanges: 1, BreakingChanges: 1, NewAPIs: 12, PackagesTouched: 5},
+			},
+			{
+				Version: "1.22",
+				Summary: "Range over integers",
+				Counts:  domain.ComparisonTotals{LanguageChanges: 2, NewAPIs: 9, PackagesTouched: 4},
+			},
+		},
+	}
+
+	result := formatter.FormatVersionCatalog(catalog)
+
+	expected := "# Supported Go Versions\n\n" +
+		"## Summary\nGo 1.22 through 1.23 (2 releases) are available\n\n" +
+		"## Releases\n" +
+		"| Version | Released | Language changes | Breaking changes | New APIs | Packages touched |\n" +
+		"| --- | --- | ---: | ---: | ---: | ---: |\n" +
+		"| Go 1.23 | 2024-08-13 | 1 | 1 | 12 | 5 |\n" +
+		"| Go 1.22 |  | 2 | 0 | 9 | 4 |\n\n" +
+		"## Highlights\n- **Go 1.23**: Iterators\n- **Go 1.22**: Range over integers\n\n" +
+		"## Note\nCounts cover the changes made by each release alone; pass any listed version to the other tools.\n"
+
+	if result != expected {
+		t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+	}
+
+	t.Run("localized", func(t *testing.T) {
+		catalog.Releases[0].SummaryTranslations = map[string]string{"ja": "イテレータ"}
+		result := NewResponseFormatter(version.NewSemanticVersionComparator(), WithLocale("ja")).FormatVersionCatalog(catalog)
+
+		expected := "# サポートされている Go のバージョン\n\n" +
+			"## 概要\nGo 1.22 から 1.23 まで (2 件のリリース) を利用できます\n\n" +
+			"## リリース\n" +
+			"| バージョン | リリース日 | 言語の変更 | 破壊的変更 | 新しい API | 変更されたパッケージ |\n" +
+			"| --- | --- | ---: | ---: | ---: | ---: |\n" +
+			"| Go 1.23 | 2024-08-13 | 1 | 1 | 12 | 5 |\n" +
+			"| Go 1.22 |  | 2 | 0 | 9 | 4 |\n\n" +
+			"## 主な変更\n- **Go 1.23**: イテレータ\n- **Go 1.22**: Range over integers\n\n" +
+			"## 補足\n件数は各リリース単独の変更数です。掲載されているバージョンは他のツールにも指定できます。\n"
+
+		if result != expected {
+			t.Errorf("Expected:\n%q\n\nGot:\n%q", expected, result)
+		}
+	})
 }
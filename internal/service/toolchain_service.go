@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// DefaultToolchainService implements ToolchainService
+type DefaultToolchainService struct {
+	repository domain.ReleaseRepository
+}
+
+// NewToolchainService creates a new toolchain service
+func NewToolchainService(repository domain.ReleaseRepository) domain.ToolchainService {
+	return &DefaultToolchainService{
+		repository: repository,
+	}
+}
+
+// GetToolchainUpdates returns the toolchain changes up to version in chronological order
+func (s *DefaultToolchainService) GetToolchainUpdates(ctx context.Context, version, command string) (*domain.ToolchainReport, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	// Validate input
+	if version == "" {
+		return nil, domain.NewValidationError("GetToolchainUpdates", "version cannot be empty", nil)
+	}
+
+	releases, err := s.repository.GetReleasesUpToVersion(ctx, version)
+	if err != nil {
+		return nil, lookupError("GetToolchainUpdates", "version not found", err).
+			WithContext("version", version)
+	}
+
+	command = normalizeCommand(command)
+	report := &domain.ToolchainReport{
+		Version: version,
+		Command: command,
+		Entries: make([]domain.ToolchainEntry, 0),
+	}
+
+	for _, release := range releases {
+		for _, change := range release.Toolchain {
+			if !matchesCommand(change.Command, command) {
+				continue
+			}
+			report.Entries = append(report.Entries, domain.ToolchainEntry{
+				ToolchainChange: change,
+				Version:         release.Version,
+			})
+		}
+	}
+
+	report.Summary = toolchainSummary(fmt.Sprintf, report)
+
+	return report, nil
+}
+
+// normalizeCommand accepts "vet", "go vet" or " go  vet " and returns "go vet"
+func normalizeCommand(command string) string {
+	command = strings.Join(strings.Fields(command), " ")
+	if command == "" || command == "go" || command == "go.mod" || strings.HasPrefix(command, "go ") {
+		return command
+	}
+	return "go " + command
+}
+
+// matchesCommand reports whether an entry belongs to the requested command, including its subcommands
+// The bare "go" command only matches the flags and variables shared by every command
+func matchesCommand(entryCommand, command string) bool {
+	if command == "" || entryCommand == command {
+		return true
+	}
+	return command != "go" && strings.HasPrefix(entryCommand, command+" ")
+}
+
+// toolchainSummary creates the summary line for a report
+func toolchainSummary(p sprintf, report *domain.ToolchainReport) string {
+	target := p("the go command")
+	if report.Command != "" {
+		target = "`" + report.Command + "`"
+	}
+	return p("%d toolchain changes for %s available in Go %s", len(report.Entries), target, report.Version)
+}
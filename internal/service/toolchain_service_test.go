@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultToolchainService_GetToolchainUpdates(t *testing.T) {
+	testReleases := []*domain.GoRelease{
+		{
+			Version: "1.18",
+			Toolchain: []domain.ToolchainChange{
+				{Command: "go test", Flag: "-fuzz", Status: "added", Description: "Fuzzing"},
+				{Command: "go work", Flag: "init/use/sync", Status: "added", Description: "Workspaces"},
+			},
+		},
+		{
+			Version: "1.21",
+			Toolchain: []domain.ToolchainChange{
+				{Command: "go", EnvVar: "GOTOOLCHAIN", Status: "added", Description: "Toolchain selection"},
+				{Command: "go vet", Flag: "-slog", Status: "added", Description: "slog checks"},
+			},
+		},
+		{
+			Version: "1.22",
+			Toolchain: []domain.ToolchainChange{
+				{Command: "go work vendor", Status: "added", Description: "Workspace vendoring"},
+			},
+		},
+	}
+
+	repo := &mockRepository{releases: testReleases}
+	service := NewToolchainService(repo)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		version  string
+		command  string
+		expected int
+	}{
+		{name: "all commands", version: "1.21", command: "", expected: 4},
+		{name: "short command name", version: "1.21", command: "vet", expected: 1},
+		{name: "subcommands included", version: "1.22", command: "go work", expected: 2},
+		{name: "global flags and variables", version: "1.22", command: "go", expected: 1},
+		{name: "later releases excluded", version: "1.21", command: "go work", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := service.GetToolchainUpdates(ctx, tt.version, tt.command)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(report.Entries) != tt.expected {
+				t.Errorf("expected %d entries, got %+v", tt.expected, report.Entries)
+			}
+		})
+	}
+
+	t.Run("repository failures are not reported as not found", func(t *testing.T) {
+		cause := domain.NewRepositoryError("GetReleasesUpToVersion", "release data is reloading", nil)
+		failing := NewToolchainService(&failingRepository{mockRepository: repo, err: cause})
+		_, err := failing.GetToolchainUpdates(ctx, "1.21", "")
+		if domain.IsNotFoundError(err) || !errors.Is(err, cause) {
+			t.Errorf("expected a service error wrapping the cause, got %v", err)
+		}
+	})
+}
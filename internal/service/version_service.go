@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// DefaultVersionService implements VersionService
+type DefaultVersionService struct {
+	repository domain.ReleaseRepository
+}
+
+// NewVersionService creates a new version service
+func NewVersionService(repository domain.ReleaseRepository) domain.VersionService {
+	return &DefaultVersionService{
+		repository: repository,
+	}
+}
+
+// ListVersions returns every available release, newest first, with the counts of its own changes
+func (s *DefaultVersionService) ListVersions(ctx context.Context, includeExperimental bool) (*domain.VersionCatalog, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	releases, err := s.repository.GetAllReleases(ctx)
+	if err != nil {
+		return nil, domain.NewServiceError("ListVersions", "failed to get releases", err)
+	}
+	if len(releases) == 0 {
+		return nil, domain.NewNotFoundError("ListVersions", "no releases available")
+	}
+
+	catalog := &domain.VersionCatalog{
+		LatestVersion: releases[0].Version,
+		OldestVersion: releases[len(releases)-1].Version,
+		Releases:      make([]domain.ReleaseOverview, 0, len(releases)),
+	}
+
+	for _, release := range releases {
+		overview := domain.ReleaseOverview{
+			Version:             release.Version,
+			ReleaseDate:         release.ReleaseDate,
+			Summary:             release.Summary,
+			SummaryTranslations: release.SummaryTranslations,
+		}
+
+		// Count the entries go-updates and go-compare-versions list for the same query
+		for _, change := range filterChanges(release.Changes, includeExperimental) {
+			countChange(&overview.Counts, change)
+		}
+		for _, changes := range release.Packages {
+			changes = filterPackageChanges(changes, includeExperimental)
+			if len(changes) == 0 {
+				continue
+			}
+			apis, breaking := countPackageChanges(changes)
+			overview.Counts.NewAPIs += apis
+			overview.Counts.BreakingChanges += breaking
+			overview.Counts.PackagesTouched++
+		}
+
+		catalog.Releases = append(catalog.Releases, overview)
+	}
+
+	catalog.Summary = catalogSummary(fmt.Sprintf, catalog)
+
+	return catalog, nil
+}
+
+// catalogSummary creates the summary line for a version catalog
+func catalogSummary(p sprintf, catalog *domain.VersionCatalog) string {
+	return p("Go %s through %s (%d releases) are available", catalog.OldestVersion, catalog.LatestVersion, len(catalog.Releases))
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestDefaultVersionService_ListVersions(t *testing.T) {
+	// GetAllReleases returns the newest release first
+	testReleases := []*domain.GoRelease{
+		{
+			Version:             "1.23",
+			Summary:             "Iterators",
+			SummaryTranslations: map[string]string{"ja": "イテレータ"},
+			Changes: []domain.Change{
+				{Category: "language", Description: "Range over functions", Impact: "new"},
+				{Category: "runtime", Description: "Timer changes", Impact: "breaking"},
+				{Category: "language", Description: "Arena semantics", Impact: "breaking", Experimental: true},
+			},
+			Packages: map[string][]domain.PackageChange{
+				"iter": {
+					{Description: "New package", Impact: "new"},
+					{Type: "Seq", Description: "Iterator type", Impact: "new"},
+				},
+				"time": {
+					{Function: "Timer.Reset", Description: "Reset returns false", Impact: "breaking"},
+				},
+				"arena": {
+					{Function: "New", Description: "New arena", Impact: "new", Experimental: true},
+				},
+			},
+		},
+		{
+			Version: "1.22",
+			Summary: "Range over integers",
+			Changes: []domain.Change{
+				{Category: "language", Description: "Range over integers", Impact: "new"},
+			},
+		},
+	}
+
+	service := NewVersionService(&mockRepository{releases: testReleases})
+
+	catalog, err := service.ListVersions(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if catalog.OldestVersion != "1.22" || catalog.LatestVersion != "1.23" {
+		t.Errorf("expected 1.22 through 1.23, got %s through %s", catalog.OldestVersion, catalog.LatestVersion)
+	}
+	if catalog.Summary != "Go 1.22 through 1.23 (2 releases) are available" {
+		t.Errorf("unexpected summary: %s", catalog.Summary)
+	}
+	if len(catalog.Releases) != 2 || catalog.Releases[0].Version != "1.23" {
+		t.Fatalf("expected releases newest first, got %+v", catalog.Releases)
+	}
+
+	expected := domain.ComparisonTotals{LanguageChanges: 1, BreakingChanges: 2, NewAPIs: 1, PackagesTouched: 2}
+	if catalog.Releases[0].Counts != expected {
+		t.Errorf("expected counts %+v, got %+v", expected, catalog.Releases[0].Counts)
+	}
+	if catalog.Releases[0].SummaryTranslations["ja"] != "イテレータ" {
+		t.Error("expected summary translations to be carried over")
+	}
+
+	t.Run("experimental entries are counted on request", func(t *testing.T) {
+		catalog, err := service.ListVersions(context.Background(), true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := domain.ComparisonTotals{LanguageChanges: 2, BreakingChanges: 3, NewAPIs: 2, PackagesTouched: 3}
+		if catalog.Releases[0].Counts != expected {
+			t.Errorf("expected counts %+v, got %+v", expected, catalog.Releases[0].Counts)
+		}
+	})
+
+	t.Run("no releases", func(t *testing.T) {
+		empty := NewVersionService(&mockRepository{})
+		if _, err := empty.ListVersions(context.Background(), false); !domain.IsNotFoundError(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
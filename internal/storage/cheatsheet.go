@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// CheatsheetFile maps package changes to the themes of the cheatsheet format, next to releases and locales
+const CheatsheetFile = "cheatsheet.json"
+
+// cheatsheetThemes is the layout of CheatsheetFile
+type cheatsheetThemes struct {
+	Themes []domain.CheatsheetTheme `json:"themes"`
+}
+
+// LoadCheatsheetThemes reads and validates a theme mapping in the layout of CheatsheetFile
+// Errors wrap fs.ErrNotExist when the file is missing
+func LoadCheatsheetThemes(fsys fs.FS, name string) ([]domain.CheatsheetTheme, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, domain.NewRepositoryError("LoadCheatsheetThemes", "failed to read cheat sheet themes", err).
+			WithContext("file", name)
+	}
+
+	var file cheatsheetThemes
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, domain.NewRepositoryError("LoadCheatsheetThemes", "failed to unmarshal cheat sheet themes", err).
+			WithContext("file", name)
+	}
+	if err := validateCheatsheetThemes(file.Themes); err != nil {
+		return nil, domain.NewRepositoryError("LoadCheatsheetThemes", err.Error(), nil).
+			WithContext("file", name)
+	}
+	return file.Themes, nil
+}
+
+// validateCheatsheetThemes rejects unnamed themes, incomplete rules and APIs mapped more than once
+func validateCheatsheetThemes(themes []domain.CheatsheetTheme) error {
+	names := make(map[string]bool, len(themes))
+	mapped := make(map[domain.CheatsheetRule]string)
+	for _, theme := range themes {
+		if theme.Name == "" || theme.Title == "" {
+			return fmt.Errorf("theme %q needs a name and a title", theme.Name)
+		}
+		if names[theme.Name] {
+			return fmt.Errorf("duplicate theme %q", theme.Name)
+		}
+		names[theme.Name] = true
+
+		for _, rule := range theme.Rules {
+			if rule.Package == "" || rule.Replaces == "" {
+				return fmt.Errorf("rule for %q in theme %q needs a package and the pattern it replaces", rule.Function, theme.Name)
+			}
+			key := domain.CheatsheetRule{Package: rule.Package, Function: rule.Function}
+			if other, exists := mapped[key]; exists {
+				api := rule.Package
+				if rule.Function != "" {
+					api += "." + rule.Function
+				}
+				return fmt.Errorf("%s is mapped by both %q and %q", api, other, theme.Name)
+			}
+			mapped[key] = theme.Name
+		}
+	}
+	return nil
+}
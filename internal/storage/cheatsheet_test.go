@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tenkoh/recent-go-mcp/data"
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+func TestLoadCheatsheetThemes(t *testing.T) {
+	t.Run("shipped themes", func(t *testing.T) {
+		themes, err := LoadCheatsheetThemes(data.FS, CheatsheetFile)
+		if err != nil {
+			t.Fatalf("Failed to load the embedded themes: %v", err)
+		}
+
+		var names []string
+		for _, theme := range themes {
+			names = append(names, theme.Name)
+		}
+		if got := strings.Join(names, ","); got != "collections,errors,http,logging,iteration" {
+			t.Errorf("unexpected themes %s", got)
+		}
+	})
+
+	t.Run("valid file", func(t *testing.T) {
+		mockFS := fstest.MapFS{"themes.json": &fstest.MapFile{Data: []byte(`{"themes": [{
+			"name": "collections",
+			"title": "Collections",
+			"title_translations": {"ja": "コレクション"},
+			"rules": [{"package": "slices", "function": "Contains", "replaces": "a loop"}]
+		}]}`)}}
+
+		themes, err := LoadCheatsheetThemes(mockFS, "themes.json")
+		if err != nil {
+			t.Fatalf("LoadCheatsheetThemes failed: %v", err)
+		}
+		rule := domain.CheatsheetRule{Package: "slices", Function: "Contains", Replaces: "a loop"}
+		if len(themes) != 1 || themes[0].TitleTranslations["ja"] != "コレクション" || themes[0].Rules[0] != rule {
+			t.Errorf("unexpected themes %+v", themes)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadCheatsheetThemes(fstest.MapFS{}, CheatsheetFile)
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("expected a not exist error, got %v", err)
+		}
+	})
+
+	invalid := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"malformed JSON", `{"themes": [`, "unmarshal"},
+		{"unnamed theme", `{"themes": [{"title": "Errors", "rules": []}]}`, "needs a name and a title"},
+		{"duplicate theme", `{"themes": [{"name": "errors", "title": "Errors"}, {"name": "errors", "title": "More errors"}]}`, `duplicate theme "errors"`},
+		{"incomplete rule", `{"themes": [{"name": "errors", "title": "Errors", "rules": [{"package": "errors", "function": "Is"}]}]}`, "needs a package and the pattern it replaces"},
+		{"API mapped twice", `{"themes": [
+			{"name": "collections", "title": "Collections", "rules": [{"package": "maps", "function": "Keys", "replaces": "a loop"}]},
+			{"name": "iteration", "title": "Iteration", "rules": [{"package": "maps", "function": "Keys", "replaces": "a loop"}]}
+		]}`, `maps.Keys is mapped by both "collections" and "iteration"`},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFS := fstest.MapFS{CheatsheetFile: &fstest.MapFile{Data: []byte(tt.content)}}
+			_, err := LoadCheatsheetThemes(mockFS, CheatsheetFile)
+
+			var appErr *domain.ApplicationError
+			if !errors.As(err, &appErr) || appErr.Type != domain.ErrTypeRepository || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected a repository error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// releasesDir holds one JSON file per release (e.g., releases/go1.22.json)
+const releasesDir = "releases"
+
+// dataDirFS serves the release and locale directories from disk and everything else from base
+type dataDirFS struct {
+	base   FullFS
+	mounts map[string]FullFS // keyed by the directory they replace; nil hides the directory
+}
+
+// NewDataDirFS replaces the release data of base with directories on disk so data can be updated without rebuilding
+// releasesDir replaces releases and localesDir replaces locales; an empty path keeps the data of base,
+// except that custom releases without a locales directory get no translations, since overlays must match their releases
+func NewDataDirFS(base FullFS, releases, locales string) FullFS {
+	mounts := make(map[string]FullFS)
+	if releases != "" {
+		mounts[releasesDir] = os.DirFS(releases).(FullFS)
+		mounts[localesDir] = nil
+	}
+	if locales != "" {
+		mounts[localesDir] = os.DirFS(locales).(FullFS)
+	}
+	return &dataDirFS{base: base, mounts: mounts}
+}
+
+// resolve returns the filesystem serving name and the name within it
+func (f *dataDirFS) resolve(op, name string) (FullFS, string, error) {
+	for dir, mounted := range f.mounts {
+		rest, ok := strings.CutPrefix(name, dir)
+		if !ok || (rest != "" && rest[0] != '/') {
+			continue
+		}
+		if mounted == nil {
+			return nil, "", &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+		}
+		if rest == "" {
+			return mounted, ".", nil
+		}
+		return mounted, path.Clean(rest[1:]), nil
+	}
+	return f.base, name, nil
+}
+
+// Open implements fs.FS
+func (f *dataDirFS) Open(name string) (fs.File, error) {
+	fsys, name, err := f.resolve("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return fsys.Open(name)
+}
+
+// ReadDir implements fs.ReadDirFS
+func (f *dataDirFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	fsys, name, err := f.resolve("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	return fsys.ReadDir(name)
+}
+
+// ReadFile implements fs.ReadFileFS
+func (f *dataDirFS) ReadFile(name string) ([]byte, error) {
+	fsys, name, err := f.resolve("readfile", name)
+	if err != nil {
+		return nil, err
+	}
+	return fsys.ReadFile(name)
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+func TestNewDataDirFS(t *testing.T) {
+	embedded := fstest.MapFS{
+		"releases/go1.21.json":   &fstest.MapFile{Data: []byte(`{"version": "1.21", "summary": "Embedded release", "changes": [], "packages": {}}`)},
+		"locales/ja/go1.21.json": &fstest.MapFile{Data: []byte(`{"version": "1.21", "summary": "埋め込み"}`)},
+	}
+
+	writeFile := func(t *testing.T, path, data string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	releases := t.TempDir()
+	writeFile(t, filepath.Join(releases, "go1.30.json"), `{"version": "1.30", "summary": "Release from disk", "changes": [], "packages": {}}`)
+
+	t.Run("releases directory hides embedded data", func(t *testing.T) {
+		repo, err := NewEmbeddedReleaseRepository(NewDataDirFS(embedded, releases, ""), version.NewSemanticVersionComparator())
+		if err != nil {
+			t.Fatalf("Failed to create repository: %v", err)
+		}
+
+		all, _ := repo.GetAllReleases(context.Background())
+		if len(all) != 1 || all[0].Version != "1.30" {
+			t.Fatalf("expected only the release from disk, got %v", all)
+		}
+		// The embedded 1.21 overlay would not match, so embedded translations are dropped
+		if len(all[0].SummaryTranslations) != 0 {
+			t.Errorf("expected no translations, got %v", all[0].SummaryTranslations)
+		}
+	})
+
+	t.Run("locales directory", func(t *testing.T) {
+		locales := t.TempDir()
+		writeFile(t, filepath.Join(locales, "ja", "go1.30.json"), `{"version": "1.30", "summary": "ディスクのリリース"}`)
+
+		repo, err := NewEmbeddedReleaseRepository(NewDataDirFS(embedded, releases, locales), version.NewSemanticVersionComparator())
+		if err != nil {
+			t.Fatalf("Failed to create repository: %v", err)
+		}
+
+		release, err := repo.GetReleaseByVersion(context.Background(), "1.30")
+		if err != nil {
+			t.Fatalf("Failed to get release: %v", err)
+		}
+		if release.SummaryTranslations["ja"] != "ディスクのリリース" {
+			t.Errorf("expected the translation from disk, got %v", release.SummaryTranslations)
+		}
+	})
+
+	t.Run("no directories keeps embedded data", func(t *testing.T) {
+		repo, err := NewEmbeddedReleaseRepository(NewDataDirFS(embedded, "", ""), version.NewSemanticVersionComparator())
+		if err != nil {
+			t.Fatalf("Failed to create repository: %v", err)
+		}
+
+		release, err := repo.GetReleaseByVersion(context.Background(), "1.21")
+		if err != nil || release.SummaryTranslations["ja"] != "埋め込み" {
+			t.Errorf("expected the embedded release and translation, got %+v (%v)", release, err)
+		}
+	})
+}
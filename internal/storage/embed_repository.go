@@ -6,6 +6,8 @@ import (
 	"io/fs"
 	"path"
 	"slices"
+	"sync"
+	"sync/atomic"
 
 	"github.com/tenkoh/recent-go-mcp/internal/domain"
 )
@@ -19,68 +21,138 @@ type FullFS interface {
 
 // EmbeddedReleaseRepository implements ReleaseRepository using embedded JSON files
 type EmbeddedReleaseRepository struct {
-	releases   []*domain.GoRelease
+	index      atomic.Pointer[releaseIndex]
 	comparator domain.VersionComparator
 	fs         FullFS
+	// verifiedOnly drops unverified entries when the data is loaded, so every query sees the same data
+	verifiedOnly bool
+
+	mu       sync.Mutex
+	onReload []func()
+}
+
+// RepositoryOption configures an EmbeddedReleaseRepository
+type RepositoryOption func(*EmbeddedReleaseRepository)
+
+// WithVerifiedOnly excludes entries that have not been verified against the upstream documentation
+// Use it in production deployments that must not surface unreviewed data; it applies to every tool
+func WithVerifiedOnly() RepositoryOption {
+	return func(r *EmbeddedReleaseRepository) {
+		r.verifiedOnly = true
+	}
 }
 
 // NewEmbeddedReleaseRepository creates a new repository with embedded data
-func NewEmbeddedReleaseRepository(filesystem FullFS, comparator domain.VersionComparator) (domain.ReleaseRepository, error) {
+func NewEmbeddedReleaseRepository(filesystem FullFS, comparator domain.VersionComparator, opts ...RepositoryOption) (domain.ReloadableRepository, error) {
 	repo := &EmbeddedReleaseRepository{
 		fs:         filesystem,
 		comparator: comparator,
 	}
+	for _, opt := range opts {
+		opt(repo)
+	}
 
-	if err := repo.loadReleases(); err != nil {
+	releases, err := repo.loadReleases()
+	if err != nil {
 		return nil, domain.NewRepositoryError("initialization", "failed to load embedded releases", err)
 	}
+	repo.index.Store(newReleaseIndex(releases, comparator))
 
 	return repo, nil
 }
 
+// Reload re-reads all release data from the filesystem and notifies registered listeners
+// The previously loaded data is kept if loading fails
+func (r *EmbeddedReleaseRepository) Reload(ctx context.Context) error {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	releases, err := r.loadReleases()
+	if err != nil {
+		return domain.NewRepositoryError("Reload", "failed to reload releases", err)
+	}
+	r.index.Store(newReleaseIndex(releases, r.comparator))
+
+	r.mu.Lock()
+	listeners := slices.Clone(r.onReload)
+	r.mu.Unlock()
+
+	// Notify outside the lock so listeners may register further callbacks
+	for _, fn := range listeners {
+		fn()
+	}
+
+	return nil
+}
+
+// OnReload registers a callback invoked after every successful Reload
+func (r *EmbeddedReleaseRepository) OnReload(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onReload = append(r.onReload, fn)
+}
+
 // loadReleases loads and sorts all embedded release data
-func (r *EmbeddedReleaseRepository) loadReleases() error {
+func (r *EmbeddedReleaseRepository) loadReleases() ([]*domain.GoRelease, error) {
 	// Read all JSON files from the embedded filesystem
-	entries, err := r.fs.ReadDir("data/releases")
+	entries, err := r.fs.ReadDir(releasesDir)
 	if err != nil {
-		return domain.NewRepositoryError("loadReleases", "failed to read embedded release directory", err)
+		return nil, domain.NewRepositoryError("loadReleases", "failed to read embedded release directory", err)
 	}
 
-	r.releases = make([]*domain.GoRelease, 0, len(entries))
+	releases := make([]*domain.GoRelease, 0, len(entries))
 
 	for _, entry := range entries {
 		if !entry.IsDir() && path.Ext(entry.Name()) == ".json" {
-			filePath := path.Join("data/releases", entry.Name())
+			filePath := path.Join(releasesDir, entry.Name())
 
 			data, err := r.fs.ReadFile(filePath)
 			if err != nil {
-				return domain.NewRepositoryError("loadReleases", "failed to read embedded file", err).
+				return nil, domain.NewRepositoryError("loadReleases", "failed to read embedded file", err).
 					WithContext("file", filePath)
 			}
 
 			var release domain.GoRelease
 			if err := json.Unmarshal(data, &release); err != nil {
-				return domain.NewRepositoryError("loadReleases", "failed to unmarshal release data", err).
+				return nil, domain.NewRepositoryError("loadReleases", "failed to unmarshal release data", err).
+					WithContext("file", filePath)
+			}
+			if err := checkReviewers(&release); err != nil {
+				return nil, domain.NewRepositoryError("loadReleases", err.Error(), nil).
 					WithContext("file", filePath)
 			}
 
-			r.releases = append(r.releases, &release)
+			releases = append(releases, &release)
 		}
 	}
 
-	if len(r.releases) == 0 {
-		return domain.NewRepositoryError("loadReleases", "no release data found in embedded filesystem", nil)
+	if len(releases) == 0 {
+		return nil, domain.NewRepositoryError("loadReleases", "no release data found in embedded filesystem", nil)
+	}
+
+	if err := r.mergeLocaleOverlays(releases); err != nil {
+		return nil, err
 	}
 
-	// Sort releases by version in descending order (newest first) using slices.SortFunc
-	slices.SortFunc(r.releases, func(a, b *domain.GoRelease) int {
-		return -r.comparator.Compare(a.Version, b.Version) // Negative for descending order
-	})
+	// Overlays are merged first, since they may translate entries that are dropped here
+	// Data without any verified entry would leave every tool empty, so verified-only mode refuses it
+	if r.verifiedOnly {
+		for _, release := range releases {
+			dropUnverified(release)
+		}
+		if !slices.ContainsFunc(releases, hasEntries) {
+			return nil, domain.NewRepositoryError("loadReleases", "verified-only mode: the release data has no verified entries", nil)
+		}
+	}
 
-	return nil
+	return releases, nil
 }
 
-// GetAllReleases returns all available Go releases
+// GetAllReleases returns all available Go releases (newest first)
 func (r *EmbeddedReleaseRepository) GetAllReleases(ctx context.Context) ([]*domain.GoRelease, error) {
 	// Check context cancellation
 	select {
@@ -90,7 +162,7 @@ func (r *EmbeddedReleaseRepository) GetAllReleases(ctx context.Context) ([]*doma
 	}
 
 	// Return a copy of the slice using slices.Clone (Go 1.21+)
-	return slices.Clone(r.releases), nil
+	return slices.Clone(r.index.Load().descending), nil
 }
 
 // GetReleaseByVersion returns a specific release by version
@@ -102,17 +174,14 @@ func (r *EmbeddedReleaseRepository) GetReleaseByVersion(ctx context.Context, ver
 	default:
 	}
 
-	// Find release using slices utilities
-	idx := slices.IndexFunc(r.releases, func(release *domain.GoRelease) bool {
-		return release.Version == version
-	})
-
-	if idx == -1 {
+	idx := r.index.Load()
+	position, exists := idx.positions[version]
+	if !exists {
 		return nil, domain.NewNotFoundError("GetReleaseByVersion", "release not found").
 			WithContext("version", version)
 	}
 
-	return r.releases[idx], nil
+	return idx.ascending[position], nil
 }
 
 // GetReleasesUpToVersion returns all releases from oldest up to the specified version
@@ -124,46 +193,83 @@ func (r *EmbeddedReleaseRepository) GetReleasesUpToVersion(ctx context.Context,
 	default:
 	}
 
-	// First, verify the target version exists
-	if _, err := r.GetReleaseByVersion(ctx, targetVersion); err != nil {
-		return nil, err
+	idx := r.index.Load()
+	position, exists := idx.positions[targetVersion]
+	if !exists {
+		return nil, domain.NewNotFoundError("GetReleasesUpToVersion", "release not found").
+			WithContext("version", targetVersion)
 	}
 
-	// Filter releases up to target version using slices utilities
-	filtered := make([]*domain.GoRelease, 0, len(r.releases))
-	for _, release := range r.releases {
-		if r.comparator.Compare(release.Version, targetVersion) <= 0 {
-			filtered = append(filtered, release)
-		}
+	// The index is already sorted from oldest to newest; copy the prefix so callers cannot reorder it
+	return slices.Clone(idx.ascending[:position+1]), nil
+}
+
+// GetReleasesAfterVersion returns all releases newer than the specified version, oldest first
+func (r *EmbeddedReleaseRepository) GetReleasesAfterVersion(ctx context.Context, version string) ([]*domain.GoRelease, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
 	}
 
-	// Sort from oldest to newest for chronological display using slices.SortFunc
-	slices.SortFunc(filtered, func(a, b *domain.GoRelease) int {
-		return r.comparator.Compare(a.Version, b.Version)
-	})
+	idx := r.index.Load()
+	position, exists := idx.positions[version]
+	if !exists {
+		return nil, domain.NewNotFoundError("GetReleasesAfterVersion", "release not found").
+			WithContext("version", version)
+	}
 
-	return filtered, nil
+	return slices.Clone(idx.ascending[position+1:]), nil
 }
 
-// GetOldestVersion returns the oldest available version
-func (r *EmbeddedReleaseRepository) GetOldestVersion(ctx context.Context) (string, error) {
+// GetPackageVersions returns the versions that changed the given package, oldest first
+func (r *EmbeddedReleaseRepository) GetPackageVersions(ctx context.Context, packageName string) ([]string, error) {
 	// Check context cancellation
 	select {
 	case <-ctx.Done():
-		return "", ctx.Err()
+		return nil, ctx.Err()
+	default:
+	}
+
+	versions, exists := r.index.Load().packageVersions[packageName]
+	if !exists {
+		return nil, domain.NewNotFoundError("GetPackageVersions", "package not found").
+			WithContext("package", packageName)
+	}
+
+	return slices.Clone(versions), nil
+}
+
+// GetPackagesUpToVersion returns the sorted import paths changed in any release up to the specified version
+func (r *EmbeddedReleaseRepository) GetPackagesUpToVersion(ctx context.Context, targetVersion string) ([]string, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	default:
 	}
 
-	if len(r.releases) == 0 {
-		return "", domain.NewRepositoryError("GetOldestVersion", "no releases available", nil)
+	idx := r.index.Load()
+	position, exists := idx.positions[targetVersion]
+	if !exists {
+		return nil, domain.NewNotFoundError("GetPackagesUpToVersion", "release not found").
+			WithContext("version", targetVersion)
 	}
 
-	// Find minimum using slices.MinFunc (more efficient than manual loop)
-	oldest := slices.MinFunc(r.releases, func(a, b *domain.GoRelease) int {
-		return r.comparator.Compare(a.Version, b.Version)
-	})
+	return slices.Clone(idx.cumulativePackages[position]), nil
+}
+
+// GetOldestVersion returns the oldest available version
+func (r *EmbeddedReleaseRepository) GetOldestVersion(ctx context.Context) (string, error) {
+	// Check context cancellation
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	default:
+	}
 
-	return oldest.Version, nil
+	return r.index.Load().oldest().Version, nil
 }
 
 // GetLatestVersion returns the latest available version
@@ -175,14 +281,5 @@ func (r *EmbeddedReleaseRepository) GetLatestVersion(ctx context.Context) (strin
 	default:
 	}
 
-	if len(r.releases) == 0 {
-		return "", domain.NewRepositoryError("GetLatestVersion", "no releases available", nil)
-	}
-
-	// Find maximum using slices.MaxFunc (more efficient than manual loop)
-	latest := slices.MaxFunc(r.releases, func(a, b *domain.GoRelease) int {
-		return r.comparator.Compare(a.Version, b.Version)
-	})
-
-	return latest.Version, nil
+	return r.index.Load().latest().Version, nil
 }
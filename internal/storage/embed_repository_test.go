@@ -2,9 +2,12 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 	"testing/fstest"
 
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
 	"github.com/tenkoh/recent-go-mcp/internal/version"
 )
 
@@ -20,7 +23,7 @@ func TestEmbeddedReleaseRepository_WithMockFS(t *testing.T) {
 
 	// Create mock filesystem using fstest.MapFS
 	mockFS := fstest.MapFS{
-		"data/releases/go1.21.json": &fstest.MapFile{
+		"releases/go1.21.json": &fstest.MapFile{
 			Data: []byte(testJSON),
 		},
 	}
@@ -49,3 +52,242 @@ func TestEmbeddedReleaseRepository_WithMockFS(t *testing.T) {
 		t.Errorf("Expected version 1.21, got %s", releases[0].Version)
 	}
 }
+
+func TestEmbeddedReleaseRepository_Reload(t *testing.T) {
+	mockFS := fstest.MapFS{
+		"releases/go1.21.json": &fstest.MapFile{
+			Data: []byte(`{"version": "1.21", "summary": "Test release", "changes": [], "packages": {}}`),
+		},
+	}
+
+	repo, err := NewEmbeddedReleaseRepository(mockFS, version.NewSemanticVersionComparator())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	notified := 0
+	repo.OnReload(func() { notified++ })
+
+	// Add a new release file and reload
+	mockFS["releases/go1.22.json"] = &fstest.MapFile{
+		Data: []byte(`{"version": "1.22", "summary": "New release", "changes": [], "packages": {}}`),
+	}
+
+	ctx := context.Background()
+	if err := repo.Reload(ctx); err != nil {
+		t.Fatalf("Failed to reload: %v", err)
+	}
+
+	if notified != 1 {
+		t.Errorf("Expected 1 reload notification, got %d", notified)
+	}
+
+	latest, err := repo.GetLatestVersion(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get latest version: %v", err)
+	}
+	if latest != "1.22" {
+		t.Errorf("Expected latest version 1.22 after reload, got %s", latest)
+	}
+
+	// A failing reload keeps the previous data and does not notify
+	mockFS["releases/go1.23.json"] = &fstest.MapFile{Data: []byte(`{invalid`)}
+	if err := repo.Reload(ctx); err == nil {
+		t.Fatal("Expected reload error for invalid data")
+	}
+	if notified != 1 {
+		t.Errorf("Expected no notification after failed reload, got %d", notified)
+	}
+	if latest, _ := repo.GetLatestVersion(ctx); latest != "1.22" {
+		t.Errorf("Expected previous data to be kept, got latest %s", latest)
+	}
+}
+
+// syntheticReleasesFS creates count releases (1.1 through 1.<count>), each touching a few packages
+func syntheticReleasesFS(count int) fstest.MapFS {
+	mockFS := fstest.MapFS{}
+	for minor := 1; minor <= count; minor++ {
+		data := fmt.Sprintf(`{
+			"version": "1.%d",
+			"summary": "Release 1.%d",
+			"changes": [{"category": "language", "description": "change", "impact": "new"}],
+			"packages": {
+				"pkg%d": [{"function": "F", "description": "d", "impact": "new"}],
+				"common": [{"function": "G%d", "description": "d", "impact": "enhancement"}]
+			}
+		}`, minor, minor, minor%5, minor)
+		mockFS[fmt.Sprintf("releases/go1.%d.json", minor)] = &fstest.MapFile{Data: []byte(data)}
+	}
+	return mockFS
+}
+
+func TestEmbeddedReleaseRepository_IndexedQueries(t *testing.T) {
+	repo, err := NewEmbeddedReleaseRepository(syntheticReleasesFS(12), version.NewSemanticVersionComparator())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	ctx := context.Background()
+
+	t.Run("releases up to version are chronological", func(t *testing.T) {
+		releases, err := repo.GetReleasesUpToVersion(ctx, "1.10")
+		if err != nil {
+			t.Fatalf("Failed to get releases: %v", err)
+		}
+
+		var versions []string
+		for _, release := range releases {
+			versions = append(versions, release.Version)
+		}
+		expected := []string{"1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "1.10"}
+		if !slices.Equal(versions, expected) {
+			t.Errorf("Expected %v, got %v", expected, versions)
+		}
+	})
+
+	t.Run("all releases are newest first", func(t *testing.T) {
+		releases, err := repo.GetAllReleases(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get releases: %v", err)
+		}
+		if releases[0].Version != "1.12" || releases[len(releases)-1].Version != "1.1" {
+			t.Errorf("Expected 1.12 ... 1.1, got %s ... %s", releases[0].Version, releases[len(releases)-1].Version)
+		}
+	})
+
+	t.Run("oldest and latest", func(t *testing.T) {
+		oldest, _ := repo.GetOldestVersion(ctx)
+		latest, _ := repo.GetLatestVersion(ctx)
+		if oldest != "1.1" || latest != "1.12" {
+			t.Errorf("Expected 1.1 and 1.12, got %s and %s", oldest, latest)
+		}
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		if _, err := repo.GetReleasesUpToVersion(ctx, "1.99"); !domain.IsNotFoundError(err) {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("releases after version", func(t *testing.T) {
+		releases, err := repo.GetReleasesAfterVersion(ctx, "1.9")
+		if err != nil {
+			t.Fatalf("Failed to get releases: %v", err)
+		}
+		versions := make([]string, 0, len(releases))
+		for _, release := range releases {
+			versions = append(versions, release.Version)
+		}
+		expected := []string{"1.10", "1.11", "1.12"}
+		if !slices.Equal(versions, expected) {
+			t.Errorf("Expected %v, got %v", expected, versions)
+		}
+
+		latest, err := repo.GetReleasesAfterVersion(ctx, "1.12")
+		if err != nil || len(latest) != 0 {
+			t.Errorf("Expected no releases after the latest version, got %d (%v)", len(latest), err)
+		}
+
+		if _, err := repo.GetReleasesAfterVersion(ctx, "1.99"); !domain.IsNotFoundError(err) {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("package versions", func(t *testing.T) {
+		versions, err := repo.GetPackageVersions(ctx, "pkg2")
+		if err != nil {
+			t.Fatalf("Failed to get package versions: %v", err)
+		}
+		expected := []string{"1.2", "1.7", "1.12"}
+		if !slices.Equal(versions, expected) {
+			t.Errorf("Expected %v, got %v", expected, versions)
+		}
+
+		if _, err := repo.GetPackageVersions(ctx, "missing"); !domain.IsNotFoundError(err) {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("packages up to version", func(t *testing.T) {
+		packages, err := repo.GetPackagesUpToVersion(ctx, "1.2")
+		if err != nil {
+			t.Fatalf("Failed to get packages: %v", err)
+		}
+		expected := []string{"common", "pkg1", "pkg2"}
+		if !slices.Equal(packages, expected) {
+			t.Errorf("Expected %v, got %v", expected, packages)
+		}
+	})
+}
+
+// linearReleasesUpTo reproduces the previous scan-and-sort implementation for benchmark comparison
+func linearReleasesUpTo(releases []*domain.GoRelease, comparator domain.VersionComparator, targetVersion string) []*domain.GoRelease {
+	if !slices.ContainsFunc(releases, func(release *domain.GoRelease) bool {
+		return release.Version == targetVersion
+	}) {
+		return nil
+	}
+
+	filtered := make([]*domain.GoRelease, 0, len(releases))
+	for _, release := range releases {
+		if comparator.Compare(release.Version, targetVersion) <= 0 {
+			filtered = append(filtered, release)
+		}
+	}
+
+	slices.SortFunc(filtered, func(a, b *domain.GoRelease) int {
+		return comparator.Compare(a.Version, b.Version)
+	})
+	return filtered
+}
+
+func BenchmarkGetReleasesUpToVersion(b *testing.B) {
+	comparator := version.NewSemanticVersionComparator()
+	repo, err := NewEmbeddedReleaseRepository(syntheticReleasesFS(40), comparator)
+	if err != nil {
+		b.Fatalf("Failed to create repository: %v", err)
+	}
+	ctx := context.Background()
+	releases, _ := repo.GetAllReleases(ctx)
+
+	b.Run("indexed", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := repo.GetReleasesUpToVersion(ctx, "1.30"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("linear-scan", func(b *testing.B) {
+		for b.Loop() {
+			if linearReleasesUpTo(releases, comparator, "1.30") == nil {
+				b.Fatal("version not found")
+			}
+		}
+	})
+}
+
+func BenchmarkGetOldestVersion(b *testing.B) {
+	comparator := version.NewSemanticVersionComparator()
+	repo, err := NewEmbeddedReleaseRepository(syntheticReleasesFS(40), comparator)
+	if err != nil {
+		b.Fatalf("Failed to create repository: %v", err)
+	}
+	ctx := context.Background()
+	releases, _ := repo.GetAllReleases(ctx)
+
+	b.Run("indexed", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := repo.GetOldestVersion(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("min-func", func(b *testing.B) {
+		for b.Loop() {
+			_ = slices.MinFunc(releases, func(a, b *domain.GoRelease) int {
+				return comparator.Compare(a.Version, b.Version)
+			})
+		}
+	})
+}
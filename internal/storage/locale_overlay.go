@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// localesDir holds one directory of overlay files per locale (e.g., locales/ja/go1.22.json)
+const localesDir = "locales"
+
+// localeOverlay holds the translations of one release for one locale
+type localeOverlay struct {
+	Version string `json:"version"`
+	Summary string `json:"summary,omitempty"`
+	// Descriptions maps the English description of a change or package change to its translation
+	Descriptions map[string]string `json:"descriptions,omitempty"`
+}
+
+// mergeLocaleOverlays merges every locale overlay file into releases
+// Missing locale data is not an error, but overlays that do not match the release data are
+func (r *EmbeddedReleaseRepository) mergeLocaleOverlays(releases []*domain.GoRelease) error {
+	locales, err := r.fs.ReadDir(localesDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return domain.NewRepositoryError("mergeLocaleOverlays", "failed to read locale directory", err)
+	}
+
+	byVersion := make(map[string]*domain.GoRelease, len(releases))
+	for _, release := range releases {
+		byVersion[release.Version] = release
+	}
+
+	for _, locale := range locales {
+		if !locale.IsDir() {
+			continue
+		}
+
+		dir := path.Join(localesDir, locale.Name())
+		entries, err := r.fs.ReadDir(dir)
+		if err != nil {
+			return domain.NewRepositoryError("mergeLocaleOverlays", "failed to read locale directory", err).
+				WithContext("dir", dir)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || path.Ext(entry.Name()) != ".json" {
+				continue
+			}
+
+			filePath := path.Join(dir, entry.Name())
+			data, err := r.fs.ReadFile(filePath)
+			if err != nil {
+				return domain.NewRepositoryError("mergeLocaleOverlays", "failed to read overlay file", err).
+					WithContext("file", filePath)
+			}
+
+			var overlay localeOverlay
+			if err := json.Unmarshal(data, &overlay); err != nil {
+				return domain.NewRepositoryError("mergeLocaleOverlays", "failed to unmarshal overlay file", err).
+					WithContext("file", filePath)
+			}
+
+			release, exists := byVersion[overlay.Version]
+			if !exists {
+				return domain.NewRepositoryError("mergeLocaleOverlays", "overlay refers to an unknown release", nil).
+					WithContext("file", filePath).
+					WithContext("version", overlay.Version)
+			}
+
+			if err := applyOverlay(release, locale.Name(), overlay); err != nil {
+				return err.WithContext("file", filePath)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyOverlay stores the overlay's translations on the matching release entries
+// Every translated description must match at least one entry so that stale translations are caught
+func applyOverlay(release *domain.GoRelease, locale string, overlay localeOverlay) *domain.ApplicationError {
+	if overlay.Summary != "" {
+		if release.SummaryTranslations == nil {
+			release.SummaryTranslations = make(map[string]string)
+		}
+		release.SummaryTranslations[locale] = overlay.Summary
+	}
+
+	for description, translation := range overlay.Descriptions {
+		matched := false
+
+		for i := range release.Changes {
+			if release.Changes[i].Description == description {
+				release.Changes[i].DescriptionTranslations = withTranslation(release.Changes[i].DescriptionTranslations, locale, translation)
+				matched = true
+			}
+		}
+		for _, changes := range release.Packages {
+			for i := range changes {
+				if changes[i].Description == description {
+					changes[i].DescriptionTranslations = withTranslation(changes[i].DescriptionTranslations, locale, translation)
+					matched = true
+				}
+			}
+		}
+
+		if !matched {
+			return domain.NewRepositoryError("mergeLocaleOverlays", "translation does not match any entry", nil).
+				WithContext("version", release.Version).
+				WithContext("description", description)
+		}
+	}
+
+	return nil
+}
+
+// withTranslation sets the translation for locale, allocating the map on first use
+func withTranslation(translations map[string]string, locale, translation string) map[string]string {
+	if translations == nil {
+		translations = make(map[string]string)
+	}
+	translations[locale] = translation
+	return translations
+}
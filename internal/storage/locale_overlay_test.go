@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+func TestEmbeddedReleaseRepository_LocaleOverlays(t *testing.T) {
+	releaseJSON := `{
+		"version": "1.21",
+		"summary": "Test release",
+		"changes": [{"category": "language", "description": "min and max builtins", "impact": "new"}],
+		"packages": {"slices": [{"function": "Sort", "description": "Sort a slice", "impact": "new"}]}
+	}`
+
+	t.Run("translations are merged", func(t *testing.T) {
+		mockFS := fstest.MapFS{
+			"releases/go1.21.json": &fstest.MapFile{Data: []byte(releaseJSON)},
+			"locales/ja/go1.21.json": &fstest.MapFile{Data: []byte(`{
+				"version": "1.21",
+				"summary": "テストリリース",
+				"descriptions": {
+					"min and max builtins": "組み込み関数 min と max",
+					"Sort a slice": "スライスをソート"
+				}
+			}`)},
+		}
+
+		repo, err := NewEmbeddedReleaseRepository(mockFS, version.NewSemanticVersionComparator())
+		if err != nil {
+			t.Fatalf("Failed to create repository: %v", err)
+		}
+
+		release, err := repo.GetReleaseByVersion(context.Background(), "1.21")
+		if err != nil {
+			t.Fatalf("Failed to get release: %v", err)
+		}
+
+		if got := release.SummaryTranslations["ja"]; got != "テストリリース" {
+			t.Errorf("Expected translated summary, got %q", got)
+		}
+		if got := release.Changes[0].DescriptionTranslations["ja"]; got != "組み込み関数 min と max" {
+			t.Errorf("Expected translated change description, got %q", got)
+		}
+		if got := release.Packages["slices"][0].DescriptionTranslations["ja"]; got != "スライスをソート" {
+			t.Errorf("Expected translated package change description, got %q", got)
+		}
+		// The English text is kept as the fallback
+		if release.Summary != "Test release" {
+			t.Errorf("Expected English summary to be kept, got %q", release.Summary)
+		}
+	})
+
+	t.Run("overlay for unknown release", func(t *testing.T) {
+		mockFS := fstest.MapFS{
+			"releases/go1.21.json":   &fstest.MapFile{Data: []byte(releaseJSON)},
+			"locales/ja/go1.99.json": &fstest.MapFile{Data: []byte(`{"version": "1.99", "summary": "不明"}`)},
+		}
+
+		if _, err := NewEmbeddedReleaseRepository(mockFS, version.NewSemanticVersionComparator()); err == nil {
+			t.Error("Expected error for overlay of unknown release")
+		}
+	})
+
+	t.Run("stale translation", func(t *testing.T) {
+		mockFS := fstest.MapFS{
+			"releases/go1.21.json": &fstest.MapFile{Data: []byte(releaseJSON)},
+			"locales/ja/go1.21.json": &fstest.MapFile{Data: []byte(`{
+				"version": "1.21",
+				"descriptions": {"removed description": "削除された説明"}
+			}`)},
+		}
+
+		if _, err := NewEmbeddedReleaseRepository(mockFS, version.NewSemanticVersionComparator()); err == nil {
+			t.Error("Expected error for translation that matches no entry")
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// releaseIndex is an immutable lookup structure built once per load
+// Every query method reads from a single index so a concurrent reload never mixes data sets
+type releaseIndex struct {
+	// ascending holds releases from oldest to newest
+	ascending []*domain.GoRelease
+	// descending holds releases from newest to oldest (the GetAllReleases order)
+	descending []*domain.GoRelease
+	// positions maps a version to its position in ascending
+	positions map[string]int
+	// packageVersions maps an import path to the versions touching it, oldest first
+	packageVersions map[string][]string
+	// cumulativePackages holds, per position, the sorted import paths touched up to and including that version
+	cumulativePackages [][]string
+}
+
+// newReleaseIndex builds an index from releases in any order
+func newReleaseIndex(releases []*domain.GoRelease, comparator domain.VersionComparator) *releaseIndex {
+	ascending := slices.Clone(releases)
+	slices.SortFunc(ascending, func(a, b *domain.GoRelease) int {
+		return comparator.Compare(a.Version, b.Version)
+	})
+
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+
+	idx := &releaseIndex{
+		ascending:          ascending,
+		descending:         descending,
+		positions:          make(map[string]int, len(ascending)),
+		packageVersions:    make(map[string][]string),
+		cumulativePackages: make([][]string, len(ascending)),
+	}
+
+	seen := make(map[string]struct{})
+	for i, release := range ascending {
+		idx.positions[release.Version] = i
+
+		for pkg := range release.Packages {
+			idx.packageVersions[pkg] = append(idx.packageVersions[pkg], release.Version)
+			seen[pkg] = struct{}{}
+		}
+
+		idx.cumulativePackages[i] = slices.Sorted(maps.Keys(seen))
+	}
+
+	return idx
+}
+
+// oldest returns the oldest release
+func (idx *releaseIndex) oldest() *domain.GoRelease {
+	return idx.ascending[0]
+}
+
+// latest returns the newest release
+func (idx *releaseIndex) latest() *domain.GoRelease {
+	return idx.ascending[len(idx.ascending)-1]
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// checkReviewers rejects entries marked as verified without naming who verified them
+func checkReviewers(release *domain.GoRelease) error {
+	for _, change := range release.Changes {
+		if change.Verified && change.ReviewedBy == "" {
+			return unreviewedError("change", change.Description)
+		}
+	}
+	for pkg, changes := range release.Packages {
+		for _, change := range changes {
+			if change.Verified && change.ReviewedBy == "" {
+				return unreviewedError("package change", pkg+" "+change.Function)
+			}
+		}
+	}
+	for _, setting := range release.Godebug {
+		if setting.Verified && setting.ReviewedBy == "" {
+			return unreviewedError("GODEBUG setting", setting.Name)
+		}
+	}
+	for _, deprecation := range release.Deprecations {
+		if deprecation.Verified && deprecation.ReviewedBy == "" {
+			return unreviewedError("deprecation", deprecation.Symbol)
+		}
+	}
+	for _, platform := range release.Platforms {
+		if platform.Verified && platform.ReviewedBy == "" {
+			return unreviewedError("platform change", platform.GOOS+"/"+platform.GOARCH)
+		}
+	}
+	for _, change := range release.Toolchain {
+		if change.Verified && change.ReviewedBy == "" {
+			return unreviewedError("toolchain change", change.Command+" "+change.Flag+change.EnvVar)
+		}
+	}
+	return nil
+}
+
+// unreviewedError reports a verified entry without reviewer
+func unreviewedError(kind, name string) error {
+	return fmt.Errorf("%s %q is marked as verified but has no reviewed_by", kind, name)
+}
+
+// hasEntries reports whether release has any change or catalogue entry
+func hasEntries(release *domain.GoRelease) bool {
+	return len(release.Changes) > 0 || len(release.Packages) > 0 || len(release.Godebug) > 0 ||
+		len(release.Deprecations) > 0 || len(release.Platforms) > 0 || len(release.Toolchain) > 0
+}
+
+// dropUnverified removes every unverified entry from release, including packages left without changes
+func dropUnverified(release *domain.GoRelease) {
+	release.Changes = slices.DeleteFunc(release.Changes, func(change domain.Change) bool { return !change.Verified })
+	for pkg, changes := range release.Packages {
+		release.Packages[pkg] = slices.DeleteFunc(changes, func(change domain.PackageChange) bool { return !change.Verified })
+	}
+	maps.DeleteFunc(release.Packages, func(_ string, changes []domain.PackageChange) bool { return len(changes) == 0 })
+	release.Godebug = slices.DeleteFunc(release.Godebug, func(setting domain.GodebugSetting) bool { return !setting.Verified })
+	release.Deprecations = slices.DeleteFunc(release.Deprecations, func(deprecation domain.Deprecation) bool { return !deprecation.Verified })
+	release.Platforms = slices.DeleteFunc(release.Platforms, func(platform domain.PlatformChange) bool { return !platform.Verified })
+	release.Toolchain = slices.DeleteFunc(release.Toolchain, func(change domain.ToolchainChange) bool { return !change.Verified })
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+)
+
+// verificationReleaseJSON has one verified and one unverified entry of every kind
+const verificationReleaseJSON = `{
+	"version": "1.22",
+	"summary": "Test release",
+	"changes": [
+		{"category": "language", "description": "For-range over integers", "impact": "new", "verified": true, "reviewed_by": "alice"},
+		{"category": "runtime", "description": "Unreviewed claim", "impact": "performance"}
+	],
+	"packages": {
+		"net/http": [{"function": "ServeMux", "description": "Enhanced routing", "impact": "enhancement", "verified": true, "reviewed_by": "alice"}],
+		"slices": [{"function": "Concat", "description": "Concatenate slices", "impact": "new"}]
+	},
+	"godebug": [
+		{"name": "httpmuxgo121", "default": "0", "description": "Routing", "verified": true, "reviewed_by": "alice"},
+		{"name": "unreviewed", "default": "1", "description": "Unreviewed setting"}
+	],
+	"deprecations": [{"symbol": "io/ioutil.ReadAll", "replacement": "io.ReadAll", "description": "Unreviewed deprecation"}],
+	"platforms": [{"goos": "darwin", "status": "updated", "min_os_version": "11", "description": "Unreviewed requirement"}],
+	"toolchain": [{"command": "go vet", "flag": "-unreviewed", "status": "added", "description": "Unreviewed flag"}]
+}`
+
+func TestEmbeddedReleaseRepository_Verification(t *testing.T) {
+	comparator := version.NewSemanticVersionComparator()
+
+	t.Run("verified only", func(t *testing.T) {
+		mockFS := fstest.MapFS{"releases/go1.22.json": &fstest.MapFile{Data: []byte(verificationReleaseJSON)}}
+		repo, err := NewEmbeddedReleaseRepository(mockFS, comparator, WithVerifiedOnly())
+		if err != nil {
+			t.Fatalf("Failed to create repository: %v", err)
+		}
+
+		release, err := repo.GetReleaseByVersion(context.Background(), "1.22")
+		if err != nil {
+			t.Fatalf("Failed to get release: %v", err)
+		}
+		if len(release.Changes) != 1 || release.Changes[0].Description != "For-range over integers" {
+			t.Errorf("expected only the verified change, got %+v", release.Changes)
+		}
+		if _, exists := release.Packages["slices"]; exists || len(release.Packages) != 1 {
+			t.Errorf("expected packages without verified entries to be dropped, got %v", release.Packages)
+		}
+		if len(release.Godebug) != 1 || len(release.Deprecations) != 0 || len(release.Platforms) != 0 || len(release.Toolchain) != 0 {
+			t.Errorf("expected unverified catalogue entries to be dropped, got %+v", release)
+		}
+
+		packages, _ := repo.GetPackagesUpToVersion(context.Background(), "1.22")
+		if len(packages) != 1 || packages[0] != "net/http" {
+			t.Errorf("expected the package index to follow the filtered data, got %v", packages)
+		}
+	})
+
+	t.Run("everything by default", func(t *testing.T) {
+		mockFS := fstest.MapFS{"releases/go1.22.json": &fstest.MapFile{Data: []byte(verificationReleaseJSON)}}
+		repo, err := NewEmbeddedReleaseRepository(mockFS, comparator)
+		if err != nil {
+			t.Fatalf("Failed to create repository: %v", err)
+		}
+		release, _ := repo.GetReleaseByVersion(context.Background(), "1.22")
+		if len(release.Changes) != 2 || len(release.Packages) != 2 || len(release.Toolchain) != 1 {
+			t.Errorf("expected unverified entries to be kept, got %+v", release)
+		}
+	})
+
+	t.Run("verified only without verified entries", func(t *testing.T) {
+		mockFS := fstest.MapFS{"releases/go1.22.json": &fstest.MapFile{Data: []byte(`{
+			"version": "1.22",
+			"changes": [{"category": "runtime", "description": "Unreviewed claim", "impact": "performance"}],
+			"packages": {}
+		}`)}}
+		_, err := NewEmbeddedReleaseRepository(mockFS, comparator, WithVerifiedOnly())
+		if err == nil || !strings.Contains(err.Error(), "no verified entries") {
+			t.Errorf("expected verified-only mode to refuse unverified data, got %v", err)
+		}
+	})
+
+	t.Run("verified without reviewer", func(t *testing.T) {
+		mockFS := fstest.MapFS{"releases/go1.22.json": &fstest.MapFile{Data: []byte(`{
+			"version": "1.22",
+			"changes": [],
+			"packages": {"slices": [{"function": "Concat", "description": "Concatenate slices", "impact": "new", "verified": true}]}
+		}`)}}
+		_, err := NewEmbeddedReleaseRepository(mockFS, comparator)
+		if err == nil || !strings.Contains(err.Error(), `package change "slices Concat" is marked as verified but has no reviewed_by`) {
+			t.Errorf("expected a missing reviewer error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package telemetry
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/tenkoh/recent-go-mcp/internal/cache"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ObserveCaches exports the hits, misses and hit rate of the named caches as observable gauges
+// The stats functions are read on every collection, so they must be safe for concurrent use
+func (t *Telemetry) ObserveCaches(caches map[string]func() cache.Stats) error {
+	hits, err := t.meter.Int64ObservableGauge("recent_go_mcp.cache.hits",
+		metric.WithDescription("Cache lookups served from the cache since startup"),
+		metric.WithUnit("{lookup}"))
+	if err != nil {
+		return err
+	}
+
+	misses, err := t.meter.Int64ObservableGauge("recent_go_mcp.cache.misses",
+		metric.WithDescription("Cache lookups that had to be computed since startup"),
+		metric.WithUnit("{lookup}"))
+	if err != nil {
+		return err
+	}
+
+	hitRate, err := t.meter.Float64ObservableGauge("recent_go_mcp.cache.hit_rate",
+		metric.WithDescription("Ratio of cache hits to lookups since startup"),
+		metric.WithUnit("1"))
+	if err != nil {
+		return err
+	}
+
+	names := slices.Sorted(maps.Keys(caches))
+	_, err = t.meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, name := range names {
+			stats := caches[name]()
+			attrs := metric.WithAttributes(AttrCache.String(name))
+			o.ObserveInt64(hits, int64(stats.Hits), attrs)
+			o.ObserveInt64(misses, int64(stats.Misses), attrs)
+			o.ObserveFloat64(hitRate, stats.HitRate(), attrs)
+		}
+		return nil
+	}, hits, misses, hitRate)
+	return err
+}
@@ -0,0 +1,38 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/tenkoh/recent-go-mcp/internal/cache"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestObserveCaches(t *testing.T) {
+	tel, _, reader := newTestTelemetry(t)
+
+	lru := cache.NewLRU[string, int](4)
+	lru.Add("a", 1)
+	lru.Get("a")
+	lru.Get("a")
+	lru.Get("a")
+	lru.Get("b")
+	if err := tel.ObserveCaches(map[string]func() cache.Stats{"features": lru.Stats}); err != nil {
+		t.Fatalf("ObserveCaches failed: %v", err)
+	}
+
+	for name, want := range map[string]int64{"recent_go_mcp.cache.hits": 3, "recent_go_mcp.cache.misses": 1} {
+		gauge, ok := collect(t, reader, name).Data.(metricdata.Gauge[int64])
+		if !ok || len(gauge.DataPoints) != 1 {
+			t.Fatalf("expected one %s data point, got %+v", name, gauge)
+		}
+		point := gauge.DataPoints[0]
+		if point.Value != want || attrValue(point.Attributes.ToSlice(), AttrCache) != "features" {
+			t.Errorf("expected %s %d for the features cache, got %+v", name, want, point)
+		}
+	}
+
+	gauge, ok := collect(t, reader, "recent_go_mcp.cache.hit_rate").Data.(metricdata.Gauge[float64])
+	if !ok || len(gauge.DataPoints) != 1 || gauge.DataPoints[0].Value != 0.75 {
+		t.Errorf("expected a hit rate of 0.75, got %+v", gauge)
+	}
+}
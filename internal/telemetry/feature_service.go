@@ -0,0 +1,45 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TracedFeatureService decorates a FeatureService with spans and latency metrics
+type TracedFeatureService struct {
+	next      domain.FeatureService
+	telemetry *Telemetry
+}
+
+// NewTracedFeatureService creates a tracing decorator around next
+func NewTracedFeatureService(next domain.FeatureService, telemetry *Telemetry) *TracedFeatureService {
+	return &TracedFeatureService{
+		next:      next,
+		telemetry: telemetry,
+	}
+}
+
+// GetFeaturesForVersion traces the wrapped call
+func (s *TracedFeatureService) GetFeaturesForVersion(ctx context.Context, targetVersion string, packageName string) (*domain.FeatureResponse, error) {
+	ctx, end := s.telemetry.startOperation(ctx, "FeatureService", "GetFeaturesForVersion",
+		AttrVersion.String(targetVersion), AttrPackage.String(packageName))
+
+	response, err := s.next.GetFeaturesForVersion(ctx, targetVersion, packageName)
+	end(err)
+	return response, err
+}
+
+// QueryFeatures traces the wrapped call
+func (s *TracedFeatureService) QueryFeatures(ctx context.Context, query domain.FeatureQuery) (*domain.FeatureResponse, error) {
+	ctx, end := s.telemetry.startOperation(ctx, "FeatureService", "QueryFeatures",
+		AttrVersion.String(query.Version),
+		AttrPackage.String(query.Package),
+		attribute.Bool("include_upcoming", query.IncludeUpcoming),
+		attribute.Bool("include_experimental", query.IncludeExperimental))
+
+	response, err := s.next.QueryFeatures(ctx, query)
+	end(err)
+	return response, err
+}
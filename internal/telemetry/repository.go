@@ -0,0 +1,100 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+)
+
+// TracedRepository decorates a ReloadableRepository with spans and latency metrics
+type TracedRepository struct {
+	next      domain.ReloadableRepository
+	telemetry *Telemetry
+}
+
+// NewTracedRepository creates a tracing decorator around next
+func NewTracedRepository(next domain.ReloadableRepository, telemetry *Telemetry) *TracedRepository {
+	return &TracedRepository{
+		next:      next,
+		telemetry: telemetry,
+	}
+}
+
+const repositoryComponent = "ReleaseRepository"
+
+// GetAllReleases traces the wrapped call
+func (r *TracedRepository) GetAllReleases(ctx context.Context) ([]*domain.GoRelease, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetAllReleases")
+	releases, err := r.next.GetAllReleases(ctx)
+	end(err)
+	return releases, err
+}
+
+// GetReleaseByVersion traces the wrapped call
+func (r *TracedRepository) GetReleaseByVersion(ctx context.Context, version string) (*domain.GoRelease, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetReleaseByVersion", AttrVersion.String(version))
+	release, err := r.next.GetReleaseByVersion(ctx, version)
+	end(err)
+	return release, err
+}
+
+// GetReleasesUpToVersion traces the wrapped call
+func (r *TracedRepository) GetReleasesUpToVersion(ctx context.Context, targetVersion string) ([]*domain.GoRelease, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetReleasesUpToVersion", AttrVersion.String(targetVersion))
+	releases, err := r.next.GetReleasesUpToVersion(ctx, targetVersion)
+	end(err)
+	return releases, err
+}
+
+// GetReleasesAfterVersion traces the wrapped call
+func (r *TracedRepository) GetReleasesAfterVersion(ctx context.Context, version string) ([]*domain.GoRelease, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetReleasesAfterVersion", AttrVersion.String(version))
+	releases, err := r.next.GetReleasesAfterVersion(ctx, version)
+	end(err)
+	return releases, err
+}
+
+// GetPackageVersions traces the wrapped call
+func (r *TracedRepository) GetPackageVersions(ctx context.Context, packageName string) ([]string, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetPackageVersions", AttrPackage.String(packageName))
+	versions, err := r.next.GetPackageVersions(ctx, packageName)
+	end(err)
+	return versions, err
+}
+
+// GetPackagesUpToVersion traces the wrapped call
+func (r *TracedRepository) GetPackagesUpToVersion(ctx context.Context, targetVersion string) ([]string, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetPackagesUpToVersion", AttrVersion.String(targetVersion))
+	packages, err := r.next.GetPackagesUpToVersion(ctx, targetVersion)
+	end(err)
+	return packages, err
+}
+
+// GetOldestVersion traces the wrapped call
+func (r *TracedRepository) GetOldestVersion(ctx context.Context) (string, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetOldestVersion")
+	version, err := r.next.GetOldestVersion(ctx)
+	end(err)
+	return version, err
+}
+
+// GetLatestVersion traces the wrapped call
+func (r *TracedRepository) GetLatestVersion(ctx context.Context) (string, error) {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "GetLatestVersion")
+	version, err := r.next.GetLatestVersion(ctx)
+	end(err)
+	return version, err
+}
+
+// Reload traces the wrapped call
+func (r *TracedRepository) Reload(ctx context.Context) error {
+	ctx, end := r.telemetry.startOperation(ctx, repositoryComponent, "Reload")
+	err := r.next.Reload(ctx)
+	end(err)
+	return err
+}
+
+// OnReload registers fn with the wrapped repository
+func (r *TracedRepository) OnReload(fn func()) {
+	r.next.OnReload(fn)
+}
@@ -0,0 +1,130 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Exporter names accepted by OTEL_TRACES_EXPORTER and OTEL_METRICS_EXPORTER
+const (
+	ExporterNone    = "none"
+	ExporterOTLP    = "otlp"
+	ExporterConsole = "console"
+)
+
+// SetupOption configures Setup
+type SetupOption func(*setupConfig)
+
+type setupConfig struct {
+	metricReaders []sdkmetric.Reader
+}
+
+// WithMetricReader adds a metric reader (e.g., the Prometheus reader of HTTP mode)
+// next to the exporter selected by OTEL_METRICS_EXPORTER; metrics are collected even when that is "none"
+func WithMetricReader(reader sdkmetric.Reader) SetupOption {
+	return func(c *setupConfig) {
+		c.metricReaders = append(c.metricReaders, reader)
+	}
+}
+
+// Setup installs global trace and meter providers chosen by the standard OpenTelemetry environment variables
+// OTEL_TRACES_EXPORTER and OTEL_METRICS_EXPORTER accept "otlp", "console" or "none" (the default),
+// and the OTLP exporters read their endpoint and headers from the usual OTEL_EXPORTER_OTLP_* variables
+// Console output goes to stderr because stdout carries the MCP protocol
+// The returned function flushes and stops the exporters
+func Setup(ctx context.Context, opts ...SetupOption) (func(context.Context) error, error) {
+	return setup(ctx, os.Getenv, os.Stderr, opts...)
+}
+
+func setup(ctx context.Context, getenv func(string) string, console io.Writer, opts ...SetupOption) (func(context.Context) error, error) {
+	var config setupConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	var shutdowns []func(context.Context) error
+	shutdown := func(ctx context.Context) error {
+		var errs []error
+		for _, fn := range shutdowns {
+			errs = append(errs, fn(ctx))
+		}
+		return errors.Join(errs...)
+	}
+
+	traceExporter, err := newTraceExporter(ctx, exporterName(getenv, "OTEL_TRACES_EXPORTER"), console)
+	if err != nil {
+		return nil, err
+	}
+	if traceExporter != nil {
+		provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(traceExporter))
+		otel.SetTracerProvider(provider)
+		shutdowns = append(shutdowns, provider.Shutdown)
+	}
+
+	metricExporter, err := newMetricExporter(ctx, exporterName(getenv, "OTEL_METRICS_EXPORTER"), console)
+	if err != nil {
+		return nil, errors.Join(err, shutdown(ctx))
+	}
+	readers := config.metricReaders
+	if metricExporter != nil {
+		readers = append(readers, sdkmetric.NewPeriodicReader(metricExporter))
+	}
+	if len(readers) > 0 {
+		var providerOpts []sdkmetric.Option
+		for _, reader := range readers {
+			providerOpts = append(providerOpts, sdkmetric.WithReader(reader))
+		}
+		provider := sdkmetric.NewMeterProvider(providerOpts...)
+		otel.SetMeterProvider(provider)
+		shutdowns = append(shutdowns, provider.Shutdown)
+	}
+
+	return shutdown, nil
+}
+
+// exporterName reads an exporter variable, defaulting to none
+func exporterName(getenv func(string) string, key string) string {
+	if name := getenv(key); name != "" {
+		return name
+	}
+	return ExporterNone
+}
+
+// newTraceExporter returns nil when traces are disabled
+func newTraceExporter(ctx context.Context, name string, console io.Writer) (sdktrace.SpanExporter, error) {
+	switch name {
+	case ExporterNone:
+		return nil, nil
+	case ExporterOTLP:
+		return otlptracehttp.New(ctx)
+	case ExporterConsole:
+		return stdouttrace.New(stdouttrace.WithWriter(console))
+	default:
+		return nil, fmt.Errorf("unsupported OTEL_TRACES_EXPORTER %q (use otlp, console or none)", name)
+	}
+}
+
+// newMetricExporter returns nil when metrics are disabled
+func newMetricExporter(ctx context.Context, name string, console io.Writer) (sdkmetric.Exporter, error) {
+	switch name {
+	case ExporterNone:
+		return nil, nil
+	case ExporterOTLP:
+		return otlpmetrichttp.New(ctx)
+	case ExporterConsole:
+		return stdoutmetric.New(stdoutmetric.WithWriter(console))
+	default:
+		return nil, fmt.Errorf("unsupported OTEL_METRICS_EXPORTER %q (use otlp, console or none)", name)
+	}
+}
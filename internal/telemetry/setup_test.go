@@ -0,0 +1,108 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// restoreGlobals resets the global providers after a test installs its own
+func restoreGlobals(t *testing.T) {
+	tracerProvider, meterProvider := otel.GetTracerProvider(), otel.GetMeterProvider()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(tracerProvider)
+		otel.SetMeterProvider(meterProvider)
+	})
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		restoreGlobals(t)
+		otel.SetTracerProvider(tracenoop.NewTracerProvider())
+		otel.SetMeterProvider(metricnoop.NewMeterProvider())
+
+		shutdown, err := setup(context.Background(), func(string) string { return "" }, &bytes.Buffer{})
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+		if _, ok := otel.GetTracerProvider().(tracenoop.TracerProvider); !ok {
+			t.Errorf("expected the no-op tracer provider to stay installed, got %T", otel.GetTracerProvider())
+		}
+		if _, ok := otel.GetMeterProvider().(metricnoop.MeterProvider); !ok {
+			t.Errorf("expected the no-op meter provider to stay installed, got %T", otel.GetMeterProvider())
+		}
+		if err := shutdown(context.Background()); err != nil {
+			t.Errorf("shutdown failed: %v", err)
+		}
+	})
+
+	t.Run("console exporters", func(t *testing.T) {
+		restoreGlobals(t)
+
+		var console bytes.Buffer
+		env := map[string]string{
+			"OTEL_TRACES_EXPORTER":  ExporterConsole,
+			"OTEL_METRICS_EXPORTER": ExporterConsole,
+		}
+		shutdown, err := setup(context.Background(), func(key string) string { return env[key] }, &console)
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+
+		tel, err := NewFromGlobal()
+		if err != nil {
+			t.Fatalf("NewFromGlobal failed: %v", err)
+		}
+		ctx, call := tel.StartToolCall(context.Background(), "go-versions", "", "")
+		call.End(ctx, 10)
+
+		if err := shutdown(context.Background()); err != nil {
+			t.Fatalf("shutdown failed: %v", err)
+		}
+		for _, want := range []string{`"Name":"tool go-versions"`, "recent_go_mcp.tool.calls"} {
+			if !strings.Contains(console.String(), want) {
+				t.Errorf("expected console output to contain %s, got:\n%s", want, console.String())
+			}
+		}
+	})
+
+	t.Run("extra metric reader without exporter", func(t *testing.T) {
+		restoreGlobals(t)
+
+		reader := sdkmetric.NewManualReader()
+		shutdown, err := setup(context.Background(), func(string) string { return "" }, &bytes.Buffer{}, WithMetricReader(reader))
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+		defer shutdown(context.Background())
+
+		tel, err := NewFromGlobal()
+		if err != nil {
+			t.Fatalf("NewFromGlobal failed: %v", err)
+		}
+		ctx, call := tel.StartToolCall(context.Background(), "go-updates", "1.22", "")
+		call.End(ctx, 10)
+
+		collect(t, reader, "recent_go_mcp.version.requests")
+	})
+
+	t.Run("unsupported exporter", func(t *testing.T) {
+		restoreGlobals(t)
+
+		_, err := setup(context.Background(), func(key string) string {
+			if key == "OTEL_METRICS_EXPORTER" {
+				return "zipkin"
+			}
+			return ""
+		}, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), "OTEL_METRICS_EXPORTER") {
+			t.Errorf("expected an unsupported exporter error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the spans and metrics emitted by this server
+const instrumentationName = "github.com/tenkoh/recent-go-mcp"
+
+// Attribute keys shared by spans and metrics
+const (
+	AttrTool      = attribute.Key("mcp.tool.name")
+	AttrVersion   = attribute.Key("go.version")
+	AttrPackage   = attribute.Key("go.package")
+	AttrComponent = attribute.Key("component")
+	AttrOperation = attribute.Key("operation")
+	AttrErrorType = attribute.Key("error.type")
+	AttrCache     = attribute.Key("cache")
+)
+
+// Telemetry holds the tracer and instruments shared by the instrumented components
+type Telemetry struct {
+	tracer            trace.Tracer
+	meter             metric.Meter
+	toolCalls         metric.Int64Counter
+	versionRequests   metric.Int64Counter
+	toolDuration      metric.Float64Histogram
+	responseSize      metric.Int64Histogram
+	operationDuration metric.Float64Histogram
+	logger            *slog.Logger
+}
+
+// Option configures Telemetry
+type Option func(*Telemetry)
+
+// WithLogger sets the logger that records every service and repository operation at debug level
+// Records are logged with the operation's context so they carry its request and session IDs
+func WithLogger(logger *slog.Logger) Option {
+	return func(t *Telemetry) {
+		t.logger = logger
+	}
+}
+
+// New creates the tracer and instruments from the given providers
+func New(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, opts ...Option) (*Telemetry, error) {
+	meter := meterProvider.Meter(instrumentationName)
+
+	toolCalls, err := meter.Int64Counter("recent_go_mcp.tool.calls",
+		metric.WithDescription("Tool calls by tool, version, package and error type"),
+		metric.WithUnit("{call}"))
+	if err != nil {
+		return nil, err
+	}
+
+	versionRequests, err := meter.Int64Counter("recent_go_mcp.version.requests",
+		metric.WithDescription("Tool calls by the Go version they asked about"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	toolDuration, err := meter.Float64Histogram("recent_go_mcp.tool.duration",
+		metric.WithDescription("Latency of tool calls"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	responseSize, err := meter.Int64Histogram("recent_go_mcp.tool.response.size",
+		metric.WithDescription("Size of tool responses"),
+		metric.WithUnit("By"))
+	if err != nil {
+		return nil, err
+	}
+
+	operationDuration, err := meter.Float64Histogram("recent_go_mcp.operation.duration",
+		metric.WithDescription("Latency of service and repository operations"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Telemetry{
+		tracer:            tracerProvider.Tracer(instrumentationName),
+		meter:             meter,
+		toolCalls:         toolCalls,
+		versionRequests:   versionRequests,
+		toolDuration:      toolDuration,
+		responseSize:      responseSize,
+		operationDuration: operationDuration,
+		logger:            slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t, nil
+}
+
+// NewFromGlobal creates telemetry from the global providers, which are no-ops unless Setup installed exporters
+func NewFromGlobal(opts ...Option) (*Telemetry, error) {
+	return New(otel.GetTracerProvider(), otel.GetMeterProvider(), opts...)
+}
+
+// startOperation starts a span for a service or repository operation
+// The returned function ends the span, records the latency and error type and logs the outcome
+func (t *Telemetry) startOperation(ctx context.Context, component, operation string, attrs ...attribute.KeyValue) (context.Context, func(error)) {
+	start := time.Now()
+	ctx, span := t.tracer.Start(ctx, component+"."+operation, trace.WithAttributes(attrs...))
+
+	return ctx, func(err error) {
+		duration := time.Since(start)
+		metricAttrs := []attribute.KeyValue{AttrComponent.String(component), AttrOperation.String(operation)}
+		logAttrs := []any{"component", component, "operation", operation, "duration", duration}
+		if err != nil {
+			errType := ErrorType(err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			span.SetAttributes(AttrErrorType.String(errType))
+			metricAttrs = append(metricAttrs, AttrErrorType.String(errType))
+			logAttrs = append(logAttrs, "errorType", errType, "error", err)
+		}
+		span.End()
+
+		t.operationDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(metricAttrs...))
+		t.logger.DebugContext(ctx, "Operation completed", logAttrs...)
+	}
+}
+
+// ErrorType returns the low-cardinality error type recorded on spans and metrics
+// Client errors wrapped by services (e.g., an unknown version) are reported by their own type
+func ErrorType(err error) string {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return "canceled"
+	}
+
+	var outer string
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		appErr, ok := e.(*domain.ApplicationError)
+		if !ok {
+			continue
+		}
+		if appErr.Type.IsClientError() {
+			return string(appErr.Type)
+		}
+		if outer == "" {
+			outer = string(appErr.Type)
+		}
+	}
+	if outer != "" {
+		return outer
+	}
+	return "unknown"
+}
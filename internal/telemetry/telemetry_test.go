@@ -0,0 +1,209 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tenkoh/recent-go-mcp/internal/domain"
+	"github.com/tenkoh/recent-go-mcp/internal/service"
+	"github.com/tenkoh/recent-go-mcp/internal/storage"
+	"github.com/tenkoh/recent-go-mcp/internal/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newTestTelemetry returns telemetry recording spans in memory and metrics in a manual reader
+func newTestTelemetry(t *testing.T) (*Telemetry, *tracetest.InMemoryExporter, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	spans := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	tel, err := New(
+		sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	if err != nil {
+		t.Fatalf("Failed to create telemetry: %v", err)
+	}
+	return tel, spans, reader
+}
+
+// findSpan returns the first recorded span named name
+func findSpan(t *testing.T, spans *tracetest.InMemoryExporter, name string) tracetest.SpanStub {
+	t.Helper()
+
+	for _, span := range spans.GetSpans() {
+		if span.Name == name {
+			return span
+		}
+	}
+	t.Fatalf("span %q not recorded; got %v", name, spanNames(spans))
+	return tracetest.SpanStub{}
+}
+
+func spanNames(spans *tracetest.InMemoryExporter) []string {
+	var names []string
+	for _, span := range spans.GetSpans() {
+		names = append(names, span.Name)
+	}
+	return names
+}
+
+// attrValue returns the value of key in attrs, or an empty string
+func attrValue(attrs []attribute.KeyValue, key attribute.Key) string {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+// collect returns the metric named name from the reader
+func collect(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Metrics {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Failed to collect metrics: %v", err)
+	}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				return m
+			}
+		}
+	}
+	t.Fatalf("metric %q not recorded", name)
+	return metricdata.Metrics{}
+}
+
+func newTestRepository(t *testing.T) domain.ReloadableRepository {
+	t.Helper()
+
+	mockFS := fstest.MapFS{}
+	for _, v := range []string{"1.21", "1.22"} {
+		mockFS[fmt.Sprintf("releases/go%s.json", v)] = &fstest.MapFile{
+			Data: []byte(`{"version": "` + v + `", "summary": "Go ` + v + `", "changes": [], "packages": {"slices": [{"function": "Concat", "description": "Concatenates slices"}]}}`),
+		}
+	}
+	repo, err := storage.NewEmbeddedReleaseRepository(mockFS, version.NewSemanticVersionComparator())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestTracedFeatureService(t *testing.T) {
+	tel, spans, reader := newTestTelemetry(t)
+	comparator := version.NewSemanticVersionComparator()
+	repo := NewTracedRepository(newTestRepository(t), tel)
+	features := NewTracedFeatureService(service.NewFeatureService(repo, comparator), tel)
+
+	t.Run("successful query", func(t *testing.T) {
+		spans.Reset()
+		if _, err := features.QueryFeatures(context.Background(), domain.FeatureQuery{Version: "1.22", Package: "slices"}); err != nil {
+			t.Fatalf("QueryFeatures failed: %v", err)
+		}
+
+		span := findSpan(t, spans, "FeatureService.QueryFeatures")
+		if got := attrValue(span.Attributes, AttrVersion); got != "1.22" {
+			t.Errorf("expected version attribute 1.22, got %q", got)
+		}
+		if got := attrValue(span.Attributes, AttrPackage); got != "slices" {
+			t.Errorf("expected package attribute slices, got %q", got)
+		}
+		if span.Status.Code == codes.Error {
+			t.Errorf("expected successful span, got %v", span.Status)
+		}
+
+		// Repository calls made by the service are children of the service span
+		child := findSpan(t, spans, "ReleaseRepository.GetReleasesUpToVersion")
+		if child.Parent.SpanID() != span.SpanContext.SpanID() {
+			t.Errorf("expected repository span to be a child of the service span")
+		}
+	})
+
+	t.Run("unknown version records error type", func(t *testing.T) {
+		spans.Reset()
+		if _, err := features.QueryFeatures(context.Background(), domain.FeatureQuery{Version: "1.99"}); err == nil {
+			t.Fatal("expected an error for an unknown version")
+		}
+
+		span := findSpan(t, spans, "FeatureService.QueryFeatures")
+		if span.Status.Code != codes.Error {
+			t.Errorf("expected error status, got %v", span.Status)
+		}
+		if got := attrValue(span.Attributes, AttrErrorType); got != string(domain.ErrTypeNotFound) {
+			t.Errorf("expected error type %s, got %q", domain.ErrTypeNotFound, got)
+		}
+		if len(span.Events) == 0 || span.Events[0].Name != "exception" {
+			t.Errorf("expected the error to be recorded as an event, got %v", span.Events)
+		}
+	})
+
+	t.Run("latency histogram", func(t *testing.T) {
+		m := collect(t, reader, "recent_go_mcp.operation.duration")
+		histogram, ok := m.Data.(metricdata.Histogram[float64])
+		if !ok {
+			t.Fatalf("expected a float64 histogram, got %T", m.Data)
+		}
+
+		found := false
+		for _, point := range histogram.DataPoints {
+			component, _ := point.Attributes.Value(AttrComponent)
+			operation, _ := point.Attributes.Value(AttrOperation)
+			errType, _ := point.Attributes.Value(AttrErrorType)
+			if component.AsString() == "FeatureService" && operation.AsString() == "QueryFeatures" && errType.AsString() == string(domain.ErrTypeNotFound) {
+				found = point.Count == 1
+			}
+		}
+		if !found {
+			t.Errorf("expected one failed QueryFeatures data point, got %+v", histogram.DataPoints)
+		}
+	})
+}
+
+func TestTracedRepository_Reload(t *testing.T) {
+	tel, spans, _ := newTestTelemetry(t)
+	repo := NewTracedRepository(newTestRepository(t), tel)
+
+	reloaded := false
+	repo.OnReload(func() { reloaded = true })
+	if err := repo.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	if !reloaded {
+		t.Error("expected OnReload callbacks to be registered with the wrapped repository")
+	}
+	findSpan(t, spans, "ReleaseRepository.Reload")
+}
+
+func TestErrorType(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"canceled", fmt.Errorf("wrapped: %w", context.Canceled), "canceled"},
+		{"client error inside service error",
+			domain.NewServiceError("QueryFeatures", "failed", domain.NewNotFoundError("GetReleaseByVersion", "release not found")),
+			string(domain.ErrTypeNotFound)},
+		{"service error", domain.NewServiceError("QueryFeatures", "failed", nil), string(domain.ErrTypeService)},
+		{"plain error", fmt.Errorf("boom"), "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorType(tt.err); got != tt.want {
+				t.Errorf("ErrorType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package telemetry
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ToolCall tracks one MCP tool call from argument binding to the response
+type ToolCall struct {
+	telemetry *Telemetry
+	span      trace.Span
+	start     time.Time
+	version   string
+	attrs     []attribute.KeyValue
+
+	mu        sync.Mutex
+	errorType string
+}
+
+type toolCallKey struct{}
+
+// StartToolCall starts the span of a tool call and stores the call in the returned context
+// version and packageName are the validated arguments; empty values are not recorded
+func (t *Telemetry) StartToolCall(ctx context.Context, tool, version, packageName string) (context.Context, *ToolCall) {
+	attrs := []attribute.KeyValue{AttrTool.String(tool)}
+	if version != "" {
+		attrs = append(attrs, AttrVersion.String(version))
+	}
+	if packageName != "" {
+		attrs = append(attrs, AttrPackage.String(packageName))
+	}
+
+	ctx, span := t.tracer.Start(ctx, "tool "+tool,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...))
+
+	call := &ToolCall{
+		telemetry: t,
+		span:      span,
+		start:     time.Now(),
+		version:   version,
+		attrs:     attrs,
+	}
+	return context.WithValue(ctx, toolCallKey{}, call), call
+}
+
+// SetToolError marks the tool call in ctx as failed with errType
+// It does nothing outside an instrumented tool call
+func SetToolError(ctx context.Context, errType string) {
+	call, ok := ctx.Value(toolCallKey{}).(*ToolCall)
+	if !ok {
+		return
+	}
+
+	call.mu.Lock()
+	call.errorType = errType
+	call.mu.Unlock()
+}
+
+// End ends the span and records the call count, latency and response size
+// Calls with a version argument are also counted per version, showing which Go versions clients are on
+func (c *ToolCall) End(ctx context.Context, responseSize int) {
+	c.mu.Lock()
+	errType := c.errorType
+	c.mu.Unlock()
+
+	attrs := slices.Clone(c.attrs)
+	if errType != "" {
+		attrs = append(attrs, AttrErrorType.String(errType))
+		c.span.SetAttributes(AttrErrorType.String(errType))
+		c.span.SetStatus(codes.Error, errType)
+	}
+	c.span.SetAttributes(attribute.Int("mcp.response.size", responseSize))
+	c.span.End()
+
+	opt := metric.WithAttributes(attrs...)
+	c.telemetry.toolCalls.Add(ctx, 1, opt)
+	c.telemetry.toolDuration.Record(ctx, time.Since(c.start).Seconds(), opt)
+	c.telemetry.responseSize.Record(ctx, int64(responseSize), opt)
+	if c.version != "" {
+		c.telemetry.versionRequests.Add(ctx, 1, metric.WithAttributes(AttrVersion.String(c.version)))
+	}
+}
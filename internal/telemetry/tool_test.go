@@ -0,0 +1,53 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestToolCall(t *testing.T) {
+	tel, spans, reader := newTestTelemetry(t)
+
+	ctx, call := tel.StartToolCall(context.Background(), "go-updates", "1.22", "")
+	SetToolError(ctx, "not_found")
+	call.End(ctx, 42)
+
+	span := findSpan(t, spans, "tool go-updates")
+	if span.SpanKind != trace.SpanKindServer {
+		t.Errorf("expected a server span, got %v", span.SpanKind)
+	}
+	if got := attrValue(span.Attributes, AttrVersion); got != "1.22" {
+		t.Errorf("expected version attribute 1.22, got %q", got)
+	}
+	if got := attrValue(span.Attributes, AttrPackage); got != "" {
+		t.Errorf("expected no package attribute, got %q", got)
+	}
+	if got := attrValue(span.Attributes, AttrErrorType); got != "not_found" || span.Status.Code != codes.Error {
+		t.Errorf("expected failed span with error type not_found, got %q (%v)", got, span.Status)
+	}
+
+	calls, ok := collect(t, reader, "recent_go_mcp.tool.calls").Data.(metricdata.Sum[int64])
+	if !ok || len(calls.DataPoints) != 1 {
+		t.Fatalf("expected one call counter data point, got %+v", calls)
+	}
+	point := calls.DataPoints[0]
+	tool, _ := point.Attributes.Value(AttrTool)
+	errType, _ := point.Attributes.Value(AttrErrorType)
+	if point.Value != 1 || tool.AsString() != "go-updates" || errType.AsString() != "not_found" {
+		t.Errorf("unexpected call counter data point %+v", point)
+	}
+
+	sizes, ok := collect(t, reader, "recent_go_mcp.tool.response.size").Data.(metricdata.Histogram[int64])
+	if !ok || len(sizes.DataPoints) != 1 || sizes.DataPoints[0].Sum != 42 {
+		t.Errorf("expected a response size of 42, got %+v", sizes)
+	}
+}
+
+func TestSetToolError_OutsideToolCall(t *testing.T) {
+	// Must not panic when no tool call is in progress (e.g., CLI mode)
+	SetToolError(context.Background(), "validation")
+}
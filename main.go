@@ -2,174 +2,117 @@ package main
 
 import (
 	"context"
-	"embed"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
-	"reflect"
+	"os/signal"
+	"strings"
+	"syscall"
 
-	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
-	"github.com/tenkoh/recent-go-mcp/internal/domain"
-	"github.com/tenkoh/recent-go-mcp/internal/service"
-	"github.com/tenkoh/recent-go-mcp/internal/storage"
-	"github.com/tenkoh/recent-go-mcp/internal/version"
+	"github.com/tenkoh/recent-go-mcp/internal/config"
+	"github.com/tenkoh/recent-go-mcp/internal/logging"
+	"github.com/tenkoh/recent-go-mcp/internal/mcpserver"
+	"github.com/tenkoh/recent-go-mcp/internal/telemetry"
 )
 
-// Version of the MCP server
-const Version = "0.2.0"
-
-// Embed all Go release data files
-//
-//go:embed data/releases/*.json
-var releasesFS embed.FS
-
-// MCPServer wraps the dependencies for the MCP server
-type MCPServer struct {
-	featureService domain.FeatureService
-	formatter      domain.ResponseFormatter
-}
-
-// NewMCPServer creates a new MCP server with dependencies initialized and tools registered
-func NewMCPServer() (*server.MCPServer, error) {
-	// Initialize dependencies
-	comparator := version.NewSemanticVersionComparator()
-
-	repo, err := storage.NewEmbeddedReleaseRepository(releasesFS, comparator)
-	if err != nil {
-		return nil, err
+func main() {
+	// A subcommand selects CLI mode; otherwise the binary runs as an MCP server over the configured transport
+	args := os.Args[1:]
+	if len(args) > 0 && (!strings.HasPrefix(args[0], "-") || isHelpArg(args[0])) {
+		os.Exit(runCLI(context.Background(), args, os.Stdout, os.Stderr))
 	}
 
-	featureService := service.NewFeatureService(repo, comparator)
-	formatter := service.NewResponseFormatter(comparator)
-
-	// Create the wrapper for dependency injection
-	mcpWrapper := &MCPServer{
-		featureService: featureService,
-		formatter:      formatter,
+	// Settings come from the config file, overridden by environment variables and then by flags
+	cfg, err := parseServerFlags(args, os.Getenv, os.Stderr)
+	if err != nil {
+		os.Exit(exitUsage)
 	}
 
-	// Create MCP server
-	s := server.NewMCPServer("recent-go-mcp", Version,
-		server.WithToolCapabilities(false))
-
-	// Define the go-updates tool
-	goUpdatesTool := mcp.NewTool("go-updates",
-		mcp.WithDescription("Get comprehensive Go language features and best practices for your project version in structured Markdown format. Supports Go 1.13-1.24, displaying all available features chronologically to help LLM coding agents use modern Go patterns and standard library functions efficiently."),
-		mcp.WithString("version",
-			mcp.Required(),
-			mcp.Description("Go version your project is currently using (supported: '1.13' through '1.24', e.g., '1.21', '1.22', '1.23', '1.24')")),
-		mcp.WithString("package",
-			mcp.Description("Optional: filter features for a specific standard library package (e.g., 'net/http', 'context', 'slices', 'maps')")))
-
-	// Add tool handler
-	s.AddTool(goUpdatesTool, mcpWrapper.handleGoUpdates)
-
-	return s, nil
-}
-
-func main() {
 	// Initialize structured logging
-	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
-	}))
+	logger, closeLog, err := logging.New(cfg.Logging)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitUsage)
+	}
+	defer closeLog()
 	slog.SetDefault(logger)
 
 	logger.Info("Initializing recent-go-mcp server",
 		"component", "recent-go-mcp",
-		"version", Version,
-		"supportedGoVersions", "1.13-1.24",
+		"version", mcpserver.Version,
 		"architecture", "clean-architecture-with-DI")
 
+	// HTTP mode also exposes the metrics in the Prometheus text format
+	httpMode := cfg.Transport.Mode == config.TransportHTTP
+	var setupOpts []telemetry.SetupOption
+	var metricsHandler http.Handler
+	if httpMode {
+		reader, handler, err := telemetry.NewPrometheusReader()
+		if err != nil {
+			logger.Error("Failed to create Prometheus exporter", "error", err)
+			os.Exit(1)
+		}
+		setupOpts = append(setupOpts, telemetry.WithMetricReader(reader))
+		metricsHandler = handler
+	}
+
+	// Exporters are chosen by the standard OTEL_* environment variables; telemetry is off by default
+	shutdownTelemetry, err := telemetry.Setup(context.Background(), setupOpts...)
+	if err != nil {
+		logger.Error("Failed to set up telemetry", "error", err)
+		os.Exit(1)
+	}
+
 	// Create MCP server with dependencies and tools
-	mcpServer, err := NewMCPServer()
+	mcpWrapper, err := mcpserver.New(cfg, mcpserver.WithLogger(logger))
 	if err != nil {
 		logger.Error("Failed to create MCP server", "error", err)
 		os.Exit(1)
 	}
-	logger.Info("MCP server created with dependencies and tools registered")
-
-	// Start server
-	logger.Info("Starting MCP server")
-	if err := server.ServeStdio(mcpServer); err != nil {
-		logger.Error("Server failed", "error", err)
+	mcpServer, err := mcpWrapper.NewMCPServer()
+	if err != nil {
+		logger.Error("Failed to create MCP server", "error", err)
 		os.Exit(1)
 	}
-}
-
-func (m *MCPServer) handleGoUpdates(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	logger := slog.Default()
-	args := request.GetArguments()
-
-	logger.Debug("Processing go-updates request", "args", args)
+	logger.Info("MCP server created with dependencies and tools registered")
 
-	// Extract version argument (required)
-	versionArg, exists := args["version"]
-	if !exists {
-		logger.Warn("Missing required version argument")
-		return mcp.NewToolResultError("version argument is required"), nil
-	}
+	// SIGHUP reloads the release data, e.g. after the files in the releases directory were updated
+	reloads := make(chan os.Signal, 1)
+	signal.Notify(reloads, syscall.SIGHUP)
+	defer signal.Stop(reloads)
+	go reloadOnSignal(reloads, mcpWrapper.Reload, logger)
 
-	version, ok := versionArg.(string)
-	if !ok {
-		logger.Warn("Invalid version argument type", "type", typeof(versionArg))
-		return mcp.NewToolResultError("version must be a string"), nil
+	// Start server
+	logger.Info("Starting MCP server")
+	var serveErr error
+	if httpMode {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		serveErr = mcpserver.ListenAndServe(ctx, cfg.Transport.HTTPAddr, mcpWrapper.HTTPHandler(mcpServer, metricsHandler))
+		stop()
+	} else {
+		serveErr = server.ServeStdio(mcpServer)
 	}
 
-	// Extract package argument (optional)
-	var packageName string
-	if pkgArg, exists := args["package"]; exists {
-		if pkg, ok := pkgArg.(string); ok {
-			packageName = pkg
-		}
+	// Flush buffered spans and metrics before exiting
+	if err := shutdownTelemetry(context.Background()); err != nil {
+		logger.Error("Failed to flush telemetry", "error", err)
 	}
-
-	logger.Info("Processing feature request",
-		"version", version,
-		"package", packageName,
-		"hasPackageFilter", packageName != "")
-
-	// Get features using the service with context
-	response, err := m.featureService.GetFeaturesForVersion(ctx, version, packageName)
-	if err != nil {
-		logger.Error("Failed to get features",
-			"error", err,
-			"version", version,
-			"package", packageName)
-
-		// Check if it's a structured error and provide better error messages
-		if domain.IsNotFoundError(err) {
-			return mcp.NewToolResultError("Version not found: " + version), nil
-		}
-		if domain.IsValidationError(err) {
-			return mcp.NewToolResultError("Invalid input: " + err.Error()), nil
-		}
-
-		return mcp.NewToolResultError("Error getting features: " + err.Error()), nil
+	if serveErr != nil {
+		logger.Error("Server failed", "error", serveErr)
+		closeLog()
+		os.Exit(1)
 	}
-
-	logger.Debug("Features retrieved successfully",
-		"changesCount", len(response.Changes),
-		"packagesCount", len(response.PackageInfo))
-
-	// Create detailed markdown response using formatter
-	markdownResponse := m.formatter.FormatAsText(response, version, packageName)
-
-	logger.Info("Request processed successfully",
-		"version", version,
-		"package", packageName,
-		"responseLength", len(markdownResponse))
-
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(markdownResponse),
-		},
-	}, nil
 }
 
-// typeof returns the type name of a value for logging
-func typeof(v any) string {
-	if v == nil {
-		return "nil"
+// reloadOnSignal reloads the release data for every signal received until signals is closed
+func reloadOnSignal(signals <-chan os.Signal, reload func(context.Context) error, logger *slog.Logger) {
+	for range signals {
+		if err := reload(context.Background()); err != nil {
+			logger.Error("Failed to reload release data", "error", err)
+			continue
+		}
+		logger.Info("Reloaded release data")
 	}
-	return reflect.TypeOf(v).String()
 }